@@ -11,11 +11,15 @@ import (
 	"github.com/sibeyzoran/EntainGroupTest/api/proto/racing"
 	"github.com/sibeyzoran/EntainGroupTest/api/proto/sports"
 	"google.golang.org/grpc"
+
+	// Registers the error detail types so they can be written out in error responses
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
 var (
-	apiEndpoint  = flag.String("api-endpoint", "localhost:8000", "API endpoint")
-	grpcEndpoint = flag.String("grpc-endpoint", "localhost:9000", "gRPC server endpoint")
+	apiEndpoint        = flag.String("api-endpoint", "localhost:8000", "API endpoint")
+	grpcEndpoint       = flag.String("grpc-endpoint", "localhost:9000", "Racing gRPC server endpoint")
+	sportsGrpcEndpoint = flag.String("sports-grpc-endpoint", "localhost:9001", "Sports gRPC server endpoint")
 )
 
 func main() {
@@ -31,22 +35,33 @@ func run() error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	racingConn, err := grpc.DialContext(ctx, *grpcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer racingConn.Close()
+
+	sportsConn, err := grpc.DialContext(ctx, *sportsGrpcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer sportsConn.Close()
+
 	mux := runtime.NewServeMux()
-	if err := racing.RegisterRacingHandlerFromEndpoint(
-		ctx,
-		mux,
-		*grpcEndpoint,
-		[]grpc.DialOption{grpc.WithInsecure()},
-	); err != nil {
+	if err := racing.RegisterRacingHandler(ctx, mux, racingConn); err != nil {
+		return err
+	}
+
+	if err := sports.RegisterSportsHandler(ctx, mux, sportsConn); err != nil {
 		return err
 	}
 
-	if err := sports.RegisterSportsHandlerFromEndpoint(
-		ctx,
+	// Search spans both services, so it is served by the gateway rather than mapped onto a single RPC
+	if err := mux.HandlePath(http.MethodGet, "/v1/search", searchHandler(
 		mux,
-		*grpcEndpoint,
-		[]grpc.DialOption{grpc.WithInsecure()},
-	); err != nil {
+		racing.NewRacingClient(racingConn),
+		sports.NewSportsClient(sportsConn),
+	)); err != nil {
 		return err
 	}
 
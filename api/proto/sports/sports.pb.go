@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,340 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How the rows of an imported file are laid out.
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	// CSV has a header naming the columns, then a line for each row.
+	ImportFormat_CSV ImportFormat = 1
+	// NDJSON has a JSON object for each row, one per line.
+	ImportFormat_NDJSON ImportFormat = 2
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "CSV",
+		2: "NDJSON",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"CSV":                       1,
+		"NDJSON":                    2,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_sports_sports_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_sports_sports_proto_enumTypes[0]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{0}
+}
+
+// Status is whether a sport event has started yet.
+type ListSportsRequestFilter_Status int32
+
+const (
+	ListSportsRequestFilter_STATUS_UNSPECIFIED ListSportsRequestFilter_Status = 0
+	// OPEN sport events are SCHEDULED or POSTPONED.
+	ListSportsRequestFilter_OPEN ListSportsRequestFilter_Status = 1
+	// CLOSED sport events are any other status.
+	ListSportsRequestFilter_CLOSED ListSportsRequestFilter_Status = 2
+)
+
+// Enum value maps for ListSportsRequestFilter_Status.
+var (
+	ListSportsRequestFilter_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "OPEN",
+		2: "CLOSED",
+	}
+	ListSportsRequestFilter_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"OPEN":               1,
+		"CLOSED":             2,
+	}
+)
+
+func (x ListSportsRequestFilter_Status) Enum() *ListSportsRequestFilter_Status {
+	p := new(ListSportsRequestFilter_Status)
+	*p = x
+	return p
+}
+
+func (x ListSportsRequestFilter_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListSportsRequestFilter_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_sports_sports_proto_enumTypes[1].Descriptor()
+}
+
+func (ListSportsRequestFilter_Status) Type() protoreflect.EnumType {
+	return &file_sports_sports_proto_enumTypes[1]
+}
+
+func (x ListSportsRequestFilter_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListSportsRequestFilter_Status.Descriptor instead.
+func (ListSportsRequestFilter_Status) EnumDescriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{4, 0}
+}
+
+// Status is the stage of its lifecycle a sport event is at.
+type SportEvent_Status int32
+
+const (
+	SportEvent_STATUS_UNSPECIFIED SportEvent_Status = 0
+	// SCHEDULED sport events haven't started yet.
+	SportEvent_SCHEDULED SportEvent_Status = 1
+	// LIVE sport events are being played.
+	SportEvent_LIVE SportEvent_Status = 2
+	// BREAK sport events are paused between periods, e.g. at half-time.
+	SportEvent_BREAK SportEvent_Status = 3
+	// FINISHED sport events have been played to the end.
+	SportEvent_FINISHED SportEvent_Status = 4
+	// POSTPONED sport events will be played at a later time.
+	SportEvent_POSTPONED SportEvent_Status = 5
+	// CANCELLED sport events will not be played or finished.
+	SportEvent_CANCELLED SportEvent_Status = 6
+)
+
+// Enum value maps for SportEvent_Status.
+var (
+	SportEvent_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "SCHEDULED",
+		2: "LIVE",
+		3: "BREAK",
+		4: "FINISHED",
+		5: "POSTPONED",
+		6: "CANCELLED",
+	}
+	SportEvent_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"SCHEDULED":          1,
+		"LIVE":               2,
+		"BREAK":              3,
+		"FINISHED":           4,
+		"POSTPONED":          5,
+		"CANCELLED":          6,
+	}
+)
+
+func (x SportEvent_Status) Enum() *SportEvent_Status {
+	p := new(SportEvent_Status)
+	*p = x
+	return p
+}
+
+func (x SportEvent_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SportEvent_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_sports_sports_proto_enumTypes[2].Descriptor()
+}
+
+func (SportEvent_Status) Type() protoreflect.EnumType {
+	return &file_sports_sports_proto_enumTypes[2]
+}
+
+func (x SportEvent_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SportEvent_Status.Descriptor instead.
+func (SportEvent_Status) EnumDescriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{24, 0}
+}
+
+// PeriodType is how a game in the sport is divided up.
+type Score_PeriodType int32
+
+const (
+	Score_PERIOD_TYPE_UNSPECIFIED Score_PeriodType = 0
+	Score_QUARTER                 Score_PeriodType = 1
+	Score_HALF                    Score_PeriodType = 2
+	Score_PERIOD                  Score_PeriodType = 3
+)
+
+// Enum value maps for Score_PeriodType.
+var (
+	Score_PeriodType_name = map[int32]string{
+		0: "PERIOD_TYPE_UNSPECIFIED",
+		1: "QUARTER",
+		2: "HALF",
+		3: "PERIOD",
+	}
+	Score_PeriodType_value = map[string]int32{
+		"PERIOD_TYPE_UNSPECIFIED": 0,
+		"QUARTER":                 1,
+		"HALF":                    2,
+		"PERIOD":                  3,
+	}
+)
+
+func (x Score_PeriodType) Enum() *Score_PeriodType {
+	p := new(Score_PeriodType)
+	*p = x
+	return p
+}
+
+func (x Score_PeriodType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Score_PeriodType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sports_sports_proto_enumTypes[3].Descriptor()
+}
+
+func (Score_PeriodType) Type() protoreflect.EnumType {
+	return &file_sports_sports_proto_enumTypes[3]
+}
+
+func (x Score_PeriodType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Score_PeriodType.Descriptor instead.
+func (Score_PeriodType) EnumDescriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{25, 0}
+}
+
+// Type is what the market is betting on.
+type Market_Type int32
+
+const (
+	Market_TYPE_UNSPECIFIED Market_Type = 0
+	// HEAD_TO_HEAD markets are on which team wins, or a draw.
+	Market_HEAD_TO_HEAD Market_Type = 1
+	// LINE markets are on which team wins once the home team's line is added to its score.
+	Market_LINE Market_Type = 2
+	// TOTAL_POINTS markets are on whether more or fewer points than the line are scored.
+	Market_TOTAL_POINTS Market_Type = 3
+)
+
+// Enum value maps for Market_Type.
+var (
+	Market_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "HEAD_TO_HEAD",
+		2: "LINE",
+		3: "TOTAL_POINTS",
+	}
+	Market_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"HEAD_TO_HEAD":     1,
+		"LINE":             2,
+		"TOTAL_POINTS":     3,
+	}
+)
+
+func (x Market_Type) Enum() *Market_Type {
+	p := new(Market_Type)
+	*p = x
+	return p
+}
+
+func (x Market_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Market_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_sports_sports_proto_enumTypes[4].Descriptor()
+}
+
+func (Market_Type) Type() protoreflect.EnumType {
+	return &file_sports_sports_proto_enumTypes[4]
+}
+
+func (x Market_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Market_Type.Descriptor instead.
+func (Market_Type) EnumDescriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{26, 0}
+}
+
+// Type is the kind of resource matched.
+type SearchResult_Type int32
+
+const (
+	SearchResult_TYPE_UNSPECIFIED SearchResult_Type = 0
+	SearchResult_SPORT_EVENT      SearchResult_Type = 1
+	SearchResult_COMPETITION      SearchResult_Type = 2
+	SearchResult_TEAM             SearchResult_Type = 3
+)
+
+// Enum value maps for SearchResult_Type.
+var (
+	SearchResult_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "SPORT_EVENT",
+		2: "COMPETITION",
+		3: "TEAM",
+	}
+	SearchResult_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"SPORT_EVENT":      1,
+		"COMPETITION":      2,
+		"TEAM":             3,
+	}
+)
+
+func (x SearchResult_Type) Enum() *SearchResult_Type {
+	p := new(SearchResult_Type)
+	*p = x
+	return p
+}
+
+func (x SearchResult_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchResult_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_sports_sports_proto_enumTypes[5].Descriptor()
+}
+
+func (SearchResult_Type) Type() protoreflect.EnumType {
+	return &file_sports_sports_proto_enumTypes[5]
+}
+
+func (x SearchResult_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchResult_Type.Descriptor instead.
+func (SearchResult_Type) EnumDescriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{35, 0}
+}
+
 // Request to GetSportByID
 type GetSportByIDRequest struct {
 	state         protoimpl.MessageState
@@ -29,6 +364,8 @@ type GetSportByIDRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// IncludePrimaryMarket embeds the head to head market in the returned sport event.
+	IncludePrimaryMarket bool `protobuf:"varint,2,opt,name=include_primary_market,json=includePrimaryMarket,proto3" json:"include_primary_market,omitempty"`
 }
 
 func (x *GetSportByIDRequest) Reset() {
@@ -70,7 +407,14 @@ func (x *GetSportByIDRequest) GetId() int64 {
 	return 0
 }
 
-//Response to GetSportByID call
+func (x *GetSportByIDRequest) GetIncludePrimaryMarket() bool {
+	if x != nil {
+		return x.IncludePrimaryMarket
+	}
+	return false
+}
+
+// Response to GetSportByID call
 type GetSportByIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -125,6 +469,10 @@ type ListSportsRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Filter *ListSportsRequestFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// PageSize is the maximum number of sports to return, up to 1000. Every sport event is returned when it is left out.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// PageToken is the next_page_token from a previous call, used to fetch the following page.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *ListSportsRequest) Reset() {
@@ -166,6 +514,20 @@ func (x *ListSportsRequest) GetFilter() *ListSportsRequestFilter {
 	return nil
 }
 
+func (x *ListSportsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSportsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 // Response to ListSports call.
 type ListSportsResponse struct {
 	state         protoimpl.MessageState
@@ -173,6 +535,8 @@ type ListSportsResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Sports []*SportEvent `protobuf:"bytes,1,rep,name=sports,proto3" json:"sports,omitempty"`
+	// NextPageToken fetches the following page, it is empty when there are no more sports.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListSportsResponse) Reset() {
@@ -214,16 +578,40 @@ func (x *ListSportsResponse) GetSports() []*SportEvent {
 	return nil
 }
 
+func (x *ListSportsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // Filter for listing sports.
 type ListSportsRequestFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids     []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	Sport   string  `protobuf:"bytes,2,opt,name=sport,proto3" json:"sport,omitempty"`
-	OrderBy string  `protobuf:"bytes,3,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
-	Sort    string  `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Only sport events of this sport, given as its slug or display name.
+	Sport   string `protobuf:"bytes,2,opt,name=sport,proto3" json:"sport,omitempty"`
+	OrderBy string `protobuf:"bytes,3,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	Sort    string `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	// Only sport events advertised to start at or after this time.
+	AdvertisedStartFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=advertised_start_from,json=advertisedStartFrom,proto3" json:"advertised_start_from,omitempty"`
+	// Only sport events advertised to start before this time.
+	AdvertisedStartTo *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=advertised_start_to,json=advertisedStartTo,proto3" json:"advertised_start_to,omitempty"`
+	// Only sport events that have or haven't started. Deprecated, use statuses.
+	//
+	// Deprecated: Do not use.
+	Status ListSportsRequestFilter_Status `protobuf:"varint,7,opt,name=status,proto3,enum=sports.ListSportsRequestFilter_Status" json:"status,omitempty"`
+	// Only sport events with any of these statuses.
+	Statuses []SportEvent_Status `protobuf:"varint,8,rep,packed,name=statuses,proto3,enum=sports.SportEvent_Status" json:"statuses,omitempty"`
+	// Only sport events in any of these competitions.
+	CompetitionIds []int64 `protobuf:"varint,9,rep,packed,name=competition_ids,json=competitionIds,proto3" json:"competition_ids,omitempty"`
+	// Only sport events where any of these teams are playing, home or away.
+	TeamIds []int64 `protobuf:"varint,10,rep,packed,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"`
+	// Fields to order by, in order of precedence. Used instead of orderBy and sort.
+	SortKeys []*SortKey `protobuf:"bytes,11,rep,name=sort_keys,json=sortKeys,proto3" json:"sort_keys,omitempty"`
 }
 
 func (x *ListSportsRequestFilter) Reset() {
@@ -286,26 +674,70 @@ func (x *ListSportsRequestFilter) GetSort() string {
 	return ""
 }
 
-// A sportEvent resource.
-type SportEvent struct {
+func (x *ListSportsRequestFilter) GetAdvertisedStartFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AdvertisedStartFrom
+	}
+	return nil
+}
+
+func (x *ListSportsRequestFilter) GetAdvertisedStartTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AdvertisedStartTo
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *ListSportsRequestFilter) GetStatus() ListSportsRequestFilter_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ListSportsRequestFilter_STATUS_UNSPECIFIED
+}
+
+func (x *ListSportsRequestFilter) GetStatuses() []SportEvent_Status {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListSportsRequestFilter) GetCompetitionIds() []int64 {
+	if x != nil {
+		return x.CompetitionIds
+	}
+	return nil
+}
+
+func (x *ListSportsRequestFilter) GetTeamIds() []int64 {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+func (x *ListSportsRequestFilter) GetSortKeys() []*SortKey {
+	if x != nil {
+		return x.SortKeys
+	}
+	return nil
+}
+
+// SortKey is one of the fields to order results by.
+type SortKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID represents a unique identifier for the sport.
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Name is the official name given to the sport.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// AdvertisedStartTime is the time the sport is advertised to run.
-	AdvertisedStartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=advertised_start_time,json=advertisedStartTime,proto3" json:"advertised_start_time,omitempty"`
-	// Sport is the type of sport the event is played in
-	Sport string `protobuf:"bytes,4,opt,name=sport,proto3" json:"sport,omitempty"`
-	// Current score is the current score of the sport
-	CurrentScore string `protobuf:"bytes,5,opt,name=current_score,json=currentScore,proto3" json:"current_score,omitempty"`
+	// Field is one of the fields orderBy accepts.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Sort is asc or desc, asc when not given.
+	Sort string `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
 }
 
-func (x *SportEvent) Reset() {
-	*x = SportEvent{}
+func (x *SortKey) Reset() {
+	*x = SortKey{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sports_sports_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -313,13 +745,13 @@ func (x *SportEvent) Reset() {
 	}
 }
 
-func (x *SportEvent) String() string {
+func (x *SortKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SportEvent) ProtoMessage() {}
+func (*SortKey) ProtoMessage() {}
 
-func (x *SportEvent) ProtoReflect() protoreflect.Message {
+func (x *SortKey) ProtoReflect() protoreflect.Message {
 	mi := &file_sports_sports_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -331,146 +763,2741 @@ func (x *SportEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SportEvent.ProtoReflect.Descriptor instead.
-func (*SportEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use SortKey.ProtoReflect.Descriptor instead.
+func (*SortKey) Descriptor() ([]byte, []int) {
 	return file_sports_sports_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SportEvent) GetId() int64 {
+func (x *SortKey) GetField() string {
 	if x != nil {
-		return x.Id
+		return x.Field
 	}
-	return 0
+	return ""
 }
 
-func (x *SportEvent) GetName() string {
+func (x *SortKey) GetSort() string {
 	if x != nil {
-		return x.Name
+		return x.Sort
 	}
 	return ""
 }
 
-func (x *SportEvent) GetAdvertisedStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.AdvertisedStartTime
-	}
-	return nil
-}
+// Request to CreateSportEvent
+type CreateSportEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *SportEvent) GetSport() string {
-	if x != nil {
-		return x.Sport
-	}
-	return ""
+	// Sport is the sport event to add. Its id, current_score, version and status
+	// are filled in by the server, and only the competitors are taken from its score.
+	// The competition and teams are optional, but have to be in the same sport.
+	Sport *SportEvent `protobuf:"bytes,1,opt,name=sport,proto3" json:"sport,omitempty"`
 }
 
-func (x *SportEvent) GetCurrentScore() string {
-	if x != nil {
-		return x.CurrentScore
+func (x *CreateSportEventRequest) Reset() {
+	*x = CreateSportEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-var File_sports_sports_proto protoreflect.FileDescriptor
-
-var file_sports_sports_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x25, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x4c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69,
-	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x73, 0x70, 0x6f, 0x72, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x15, 0x61, 0x64, 0x76,
-	0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6f,
-	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
-	0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x32, 0xcd, 0x01, 0x0a, 0x06, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
-	0x5f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x19, 0x2e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76,
-	0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x01, 0x2a,
-	0x12, 0x62, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x49, 0x44,
-	0x12, 0x1b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f,
-	0x72, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x42, 0x09, 0x5a, 0x07, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *CreateSportEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var (
-	file_sports_sports_proto_rawDescOnce sync.Once
-	file_sports_sports_proto_rawDescData = file_sports_sports_proto_rawDesc
-)
+func (*CreateSportEventRequest) ProtoMessage() {}
 
-func file_sports_sports_proto_rawDescGZIP() []byte {
-	file_sports_sports_proto_rawDescOnce.Do(func() {
-		file_sports_sports_proto_rawDescData = protoimpl.X.CompressGZIP(file_sports_sports_proto_rawDescData)
-	})
-	return file_sports_sports_proto_rawDescData
+func (x *CreateSportEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_sports_sports_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_sports_sports_proto_goTypes = []interface{}{
-	(*GetSportByIDRequest)(nil),     // 0: sports.GetSportByIDRequest
-	(*GetSportByIDResponse)(nil),    // 1: sports.GetSportByIDResponse
-	(*ListSportsRequest)(nil),       // 2: sports.ListSportsRequest
-	(*ListSportsResponse)(nil),      // 3: sports.ListSportsResponse
-	(*ListSportsRequestFilter)(nil), // 4: sports.ListSportsRequestFilter
-	(*SportEvent)(nil),              // 5: sports.sportEvent
-	(*timestamppb.Timestamp)(nil),   // 6: google.protobuf.Timestamp
-}
-var file_sports_sports_proto_depIdxs = []int32{
-	5, // 0: sports.GetSportByIDResponse.sport:type_name -> sports.sportEvent
-	4, // 1: sports.ListSportsRequest.filter:type_name -> sports.ListSportsRequestFilter
-	5, // 2: sports.ListSportsResponse.sports:type_name -> sports.sportEvent
-	6, // 3: sports.sportEvent.advertised_start_time:type_name -> google.protobuf.Timestamp
-	2, // 4: sports.Sports.ListSports:input_type -> sports.ListSportsRequest
-	0, // 5: sports.Sports.GetSportByID:input_type -> sports.GetSportByIDRequest
-	3, // 6: sports.Sports.ListSports:output_type -> sports.ListSportsResponse
-	1, // 7: sports.Sports.GetSportByID:output_type -> sports.GetSportByIDResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+// Deprecated: Use CreateSportEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateSportEventRequest) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{6}
 }
 
-func init() { file_sports_sports_proto_init() }
-func file_sports_sports_proto_init() {
+func (x *CreateSportEventRequest) GetSport() *SportEvent {
+	if x != nil {
+		return x.Sport
+	}
+	return nil
+}
+
+// Response to CreateSportEvent call.
+type CreateSportEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sport *SportEvent `protobuf:"bytes,1,opt,name=sport,proto3" json:"sport,omitempty"`
+}
+
+func (x *CreateSportEventResponse) Reset() {
+	*x = CreateSportEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSportEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSportEventResponse) ProtoMessage() {}
+
+func (x *CreateSportEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSportEventResponse.ProtoReflect.Descriptor instead.
+func (*CreateSportEventResponse) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateSportEventResponse) GetSport() *SportEvent {
+	if x != nil {
+		return x.Sport
+	}
+	return nil
+}
+
+// Request to UpdateSportEvent
+type UpdateSportEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sport holds the id of the sport event to update, the new values and the
+	// version it was read at. A version of zero skips the check for conflicting writes.
+	Sport *SportEvent `protobuf:"bytes,1,opt,name=sport,proto3" json:"sport,omitempty"`
+	// UpdateMask names the fields to change, any of name, advertised_start_time,
+	// sport, competition_id, home_team_id, away_team_id, score.home_competitor and
+	// score.away_competitor. The points are changed with UpdateScore.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateSportEventRequest) Reset() {
+	*x = UpdateSportEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSportEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSportEventRequest) ProtoMessage() {}
+
+func (x *UpdateSportEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSportEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSportEventRequest) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateSportEventRequest) GetSport() *SportEvent {
+	if x != nil {
+		return x.Sport
+	}
+	return nil
+}
+
+func (x *UpdateSportEventRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Response to UpdateSportEvent call.
+type UpdateSportEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sport *SportEvent `protobuf:"bytes,1,opt,name=sport,proto3" json:"sport,omitempty"`
+}
+
+func (x *UpdateSportEventResponse) Reset() {
+	*x = UpdateSportEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSportEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSportEventResponse) ProtoMessage() {}
+
+func (x *UpdateSportEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSportEventResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSportEventResponse) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateSportEventResponse) GetSport() *SportEvent {
+	if x != nil {
+		return x.Sport
+	}
+	return nil
+}
+
+// Request to TransitionSportEvent
+type TransitionSportEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Status is the status to move the sport event to.
+	Status SportEvent_Status `protobuf:"varint,2,opt,name=status,proto3,enum=sports.SportEvent_Status" json:"status,omitempty"`
+}
+
+func (x *TransitionSportEventRequest) Reset() {
+	*x = TransitionSportEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitionSportEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionSportEventRequest) ProtoMessage() {}
+
+func (x *TransitionSportEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionSportEventRequest.ProtoReflect.Descriptor instead.
+func (*TransitionSportEventRequest) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TransitionSportEventRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransitionSportEventRequest) GetStatus() SportEvent_Status {
+	if x != nil {
+		return x.Status
+	}
+	return SportEvent_STATUS_UNSPECIFIED
+}
+
+// Response to TransitionSportEvent call.
+type TransitionSportEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sport *SportEvent `protobuf:"bytes,1,opt,name=sport,proto3" json:"sport,omitempty"`
+}
+
+func (x *TransitionSportEventResponse) Reset() {
+	*x = TransitionSportEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitionSportEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionSportEventResponse) ProtoMessage() {}
+
+func (x *TransitionSportEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionSportEventResponse.ProtoReflect.Descriptor instead.
+func (*TransitionSportEventResponse) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransitionSportEventResponse) GetSport() *SportEvent {
+	if x != nil {
+		return x.Sport
+	}
+	return nil
+}
+
+// Request to UpdateScore
+type UpdateScoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HomeScore int64 `protobuf:"varint,2,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore int64 `protobuf:"varint,3,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	// Periods is the breakdown of the score by period, replacing any given before.
+	// It is left as it was when empty. The points have to add up to the score, in periods the sport is played over.
+	Periods []*PeriodScore `protobuf:"bytes,4,rep,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (x *UpdateScoreRequest) Reset() {
+	*x = UpdateScoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScoreRequest) ProtoMessage() {}
+
+func (x *UpdateScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScoreRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScoreRequest) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateScoreRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateScoreRequest) GetHomeScore() int64 {
+	if x != nil {
+		return x.HomeScore
+	}
+	return 0
+}
+
+func (x *UpdateScoreRequest) GetAwayScore() int64 {
+	if x != nil {
+		return x.AwayScore
+	}
+	return 0
+}
+
+func (x *UpdateScoreRequest) GetPeriods() []*PeriodScore {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+// Response to UpdateScore call.
+type UpdateScoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sport *SportEvent `protobuf:"bytes,1,opt,name=sport,proto3" json:"sport,omitempty"`
+	// Update is the score change that was recorded.
+	Update *ScoreUpdate `protobuf:"bytes,2,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (x *UpdateScoreResponse) Reset() {
+	*x = UpdateScoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScoreResponse) ProtoMessage() {}
+
+func (x *UpdateScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScoreResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScoreResponse) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateScoreResponse) GetSport() *SportEvent {
+	if x != nil {
+		return x.Sport
+	}
+	return nil
+}
+
+func (x *UpdateScoreResponse) GetUpdate() *ScoreUpdate {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+// Request to GetSportEventMarkets
+type GetSportEventMarketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSportEventMarketsRequest) Reset() {
+	*x = GetSportEventMarketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSportEventMarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSportEventMarketsRequest) ProtoMessage() {}
+
+func (x *GetSportEventMarketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSportEventMarketsRequest.ProtoReflect.Descriptor instead.
+func (*GetSportEventMarketsRequest) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSportEventMarketsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Response to GetSportEventMarkets call.
+type GetSportEventMarketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Markets []*Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+}
+
+func (x *GetSportEventMarketsResponse) Reset() {
+	*x = GetSportEventMarketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSportEventMarketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSportEventMarketsResponse) ProtoMessage() {}
+
+func (x *GetSportEventMarketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSportEventMarketsResponse.ProtoReflect.Descriptor instead.
+func (*GetSportEventMarketsResponse) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSportEventMarketsResponse) GetMarkets() []*Market {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+// Request to ListSportTypes
+type ListSportTypesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSportTypesRequest) Reset() {
+	*x = ListSportTypesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSportTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSportTypesRequest) ProtoMessage() {}
+
+func (x *ListSportTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSportTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListSportTypesRequest) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{16}
+}
+
+// Response to ListSportTypes call.
+type ListSportTypesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SportTypes are in the order they should be displayed.
+	SportTypes []*SportType `protobuf:"bytes,1,rep,name=sport_types,json=sportTypes,proto3" json:"sport_types,omitempty"`
+}
+
+func (x *ListSportTypesResponse) Reset() {
+	*x = ListSportTypesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSportTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSportTypesResponse) ProtoMessage() {}
+
+func (x *ListSportTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSportTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListSportTypesResponse) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListSportTypesResponse) GetSportTypes() []*SportType {
+	if x != nil {
+		return x.SportTypes
+	}
+	return nil
+}
+
+// Request to ListCompetitions
+type ListCompetitionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ListCompetitionsRequestFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListCompetitionsRequest) Reset() {
+	*x = ListCompetitionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCompetitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompetitionsRequest) ProtoMessage() {}
+
+func (x *ListCompetitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompetitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCompetitionsRequest) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListCompetitionsRequest) GetFilter() *ListCompetitionsRequestFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Response to ListCompetitions call.
+type ListCompetitionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Competitions []*Competition `protobuf:"bytes,1,rep,name=competitions,proto3" json:"competitions,omitempty"`
+}
+
+func (x *ListCompetitionsResponse) Reset() {
+	*x = ListCompetitionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCompetitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompetitionsResponse) ProtoMessage() {}
+
+func (x *ListCompetitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompetitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCompetitionsResponse) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListCompetitionsResponse) GetCompetitions() []*Competition {
+	if x != nil {
+		return x.Competitions
+	}
+	return nil
+}
+
+// Request to ListTeams
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ListTeamsRequestFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListTeamsRequest) GetFilter() *ListTeamsRequestFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Response to ListTeams call.
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Teams []*Team `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+// Filter for listing competitions.
+type ListCompetitionsRequestFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids   []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Sport string  `protobuf:"bytes,2,opt,name=sport,proto3" json:"sport,omitempty"`
+}
+
+func (x *ListCompetitionsRequestFilter) Reset() {
+	*x = ListCompetitionsRequestFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCompetitionsRequestFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompetitionsRequestFilter) ProtoMessage() {}
+
+func (x *ListCompetitionsRequestFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompetitionsRequestFilter.ProtoReflect.Descriptor instead.
+func (*ListCompetitionsRequestFilter) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListCompetitionsRequestFilter) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ListCompetitionsRequestFilter) GetSport() string {
+	if x != nil {
+		return x.Sport
+	}
+	return ""
+}
+
+// Filter for listing teams.
+type ListTeamsRequestFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids            []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	CompetitionIds []int64 `protobuf:"varint,2,rep,packed,name=competition_ids,json=competitionIds,proto3" json:"competition_ids,omitempty"`
+	Sport          string  `protobuf:"bytes,3,opt,name=sport,proto3" json:"sport,omitempty"`
+}
+
+func (x *ListTeamsRequestFilter) Reset() {
+	*x = ListTeamsRequestFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTeamsRequestFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequestFilter) ProtoMessage() {}
+
+func (x *ListTeamsRequestFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequestFilter.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequestFilter) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTeamsRequestFilter) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ListTeamsRequestFilter) GetCompetitionIds() []int64 {
+	if x != nil {
+		return x.CompetitionIds
+	}
+	return nil
+}
+
+func (x *ListTeamsRequestFilter) GetSport() string {
+	if x != nil {
+		return x.Sport
+	}
+	return ""
+}
+
+// A sportEvent resource.
+type SportEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID represents a unique identifier for the sport.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name is the official name given to the sport.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// AdvertisedStartTime is the time the sport is advertised to run.
+	AdvertisedStartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=advertised_start_time,json=advertisedStartTime,proto3" json:"advertised_start_time,omitempty"`
+	// Sport is the slug of the sport type the event is played in
+	Sport string `protobuf:"bytes,4,opt,name=sport,proto3" json:"sport,omitempty"`
+	// Current score is the current score of the sport shown as "home-away",
+	// it is made from the points in score and is 0-0 until the event starts.
+	CurrentScore string `protobuf:"bytes,5,opt,name=current_score,json=currentScore,proto3" json:"current_score,omitempty"`
+	// Version goes up every time the sport event is changed.
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// Score is who is playing and how many points each side has.
+	Score *Score `protobuf:"bytes,7,opt,name=score,proto3" json:"score,omitempty"`
+	// Status is where the sport event is up to in its lifecycle.
+	Status SportEvent_Status `protobuf:"varint,8,opt,name=status,proto3,enum=sports.SportEvent_Status" json:"status,omitempty"`
+	// CompetitionID represents a unique identifier for the competition the sport event is part of.
+	CompetitionId int64 `protobuf:"varint,9,opt,name=competition_id,json=competitionId,proto3" json:"competition_id,omitempty"`
+	// HomeTeamID represents a unique identifier for the home team.
+	HomeTeamId int64 `protobuf:"varint,10,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	// AwayTeamID represents a unique identifier for the away team.
+	AwayTeamId int64 `protobuf:"varint,11,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
+	// PrimaryMarket is the head to head market, only populated when requested.
+	PrimaryMarket *Market `protobuf:"bytes,12,opt,name=primary_market,json=primaryMarket,proto3" json:"primary_market,omitempty"`
+}
+
+func (x *SportEvent) Reset() {
+	*x = SportEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SportEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SportEvent) ProtoMessage() {}
+
+func (x *SportEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SportEvent.ProtoReflect.Descriptor instead.
+func (*SportEvent) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SportEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SportEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SportEvent) GetAdvertisedStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AdvertisedStartTime
+	}
+	return nil
+}
+
+func (x *SportEvent) GetSport() string {
+	if x != nil {
+		return x.Sport
+	}
+	return ""
+}
+
+func (x *SportEvent) GetCurrentScore() string {
+	if x != nil {
+		return x.CurrentScore
+	}
+	return ""
+}
+
+func (x *SportEvent) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SportEvent) GetScore() *Score {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+func (x *SportEvent) GetStatus() SportEvent_Status {
+	if x != nil {
+		return x.Status
+	}
+	return SportEvent_STATUS_UNSPECIFIED
+}
+
+func (x *SportEvent) GetCompetitionId() int64 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *SportEvent) GetHomeTeamId() int64 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *SportEvent) GetAwayTeamId() int64 {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return 0
+}
+
+func (x *SportEvent) GetPrimaryMarket() *Market {
+	if x != nil {
+		return x.PrimaryMarket
+	}
+	return nil
+}
+
+// The score of a sport event.
+type Score struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// HomeCompetitor and AwayCompetitor are the names of the home and away teams,
+	// or the names given when the sport event has no teams.
+	HomeCompetitor string `protobuf:"bytes,1,opt,name=home_competitor,json=homeCompetitor,proto3" json:"home_competitor,omitempty"`
+	AwayCompetitor string `protobuf:"bytes,2,opt,name=away_competitor,json=awayCompetitor,proto3" json:"away_competitor,omitempty"`
+	HomePoints     int64  `protobuf:"varint,3,opt,name=home_points,json=homePoints,proto3" json:"home_points,omitempty"`
+	AwayPoints     int64  `protobuf:"varint,4,opt,name=away_points,json=awayPoints,proto3" json:"away_points,omitempty"`
+	// Periods are the points scored in each period played so far, in order.
+	Periods []*PeriodScore `protobuf:"bytes,5,rep,name=periods,proto3" json:"periods,omitempty"`
+	// PeriodType is how the periods are divided for the sport.
+	PeriodType Score_PeriodType `protobuf:"varint,6,opt,name=period_type,json=periodType,proto3,enum=sports.Score_PeriodType" json:"period_type,omitempty"`
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Score) GetHomeCompetitor() string {
+	if x != nil {
+		return x.HomeCompetitor
+	}
+	return ""
+}
+
+func (x *Score) GetAwayCompetitor() string {
+	if x != nil {
+		return x.AwayCompetitor
+	}
+	return ""
+}
+
+func (x *Score) GetHomePoints() int64 {
+	if x != nil {
+		return x.HomePoints
+	}
+	return 0
+}
+
+func (x *Score) GetAwayPoints() int64 {
+	if x != nil {
+		return x.AwayPoints
+	}
+	return 0
+}
+
+func (x *Score) GetPeriods() []*PeriodScore {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *Score) GetPeriodType() Score_PeriodType {
+	if x != nil {
+		return x.PeriodType
+	}
+	return Score_PERIOD_TYPE_UNSPECIFIED
+}
+
+// A betting market on a sport event.
+type Market struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID represents a unique identifier for the market.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// SportEventID represents the sport event the market is on.
+	SportEventId int64       `protobuf:"varint,2,opt,name=sport_event_id,json=sportEventId,proto3" json:"sport_event_id,omitempty"`
+	Type         Market_Type `protobuf:"varint,3,opt,name=type,proto3,enum=sports.Market_Type" json:"type,omitempty"`
+	// Name is the name of the market shown to customers, e.g. Line -5.5.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Line is the handicap given to the home team in a LINE market, or the
+	// number of points in a TOTAL_POINTS market. It is zero for HEAD_TO_HEAD.
+	Line float64 `protobuf:"fixed64,5,opt,name=line,proto3" json:"line,omitempty"`
+	// Primary represents whether this is the main market shown for the sport event.
+	Primary bool `protobuf:"varint,6,opt,name=primary,proto3" json:"primary,omitempty"`
+	// Selections are the outcomes that can be bet on.
+	Selections []*Selection `protobuf:"bytes,7,rep,name=selections,proto3" json:"selections,omitempty"`
+}
+
+func (x *Market) Reset() {
+	*x = Market{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Market) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Market) ProtoMessage() {}
+
+func (x *Market) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Market.ProtoReflect.Descriptor instead.
+func (*Market) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Market) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Market) GetSportEventId() int64 {
+	if x != nil {
+		return x.SportEventId
+	}
+	return 0
+}
+
+func (x *Market) GetType() Market_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Market_TYPE_UNSPECIFIED
+}
+
+func (x *Market) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Market) GetLine() float64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *Market) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+func (x *Market) GetSelections() []*Selection {
+	if x != nil {
+		return x.Selections
+	}
+	return nil
+}
+
+// An outcome of a market that can be bet on.
+type Selection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID represents a unique identifier for the selection.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// MarketID represents the market the selection is in.
+	MarketId int64 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// Name is the outcome, e.g. the team name, Draw, Over or Under.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Price is the fixed odds price for the selection.
+	Price float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *Selection) Reset() {
+	*x = Selection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Selection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Selection) ProtoMessage() {}
+
+func (x *Selection) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Selection.ProtoReflect.Descriptor instead.
+func (*Selection) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Selection) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Selection) GetMarketId() int64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *Selection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Selection) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// A sport type resource, one of the sports in the catalogue.
+type SportType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID represents a unique identifier for the sport type.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Slug identifies the sport in requests and on sport events, e.g. rugby-league.
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	// DisplayName is the name of the sport shown to customers, e.g. Rugby League.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Icon is the path of the icon shown for the sport.
+	Icon string `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	// DisplayOrder is where the sport is shown in lists, lowest first.
+	DisplayOrder int32 `protobuf:"varint,5,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	// PeriodType is how a game of the sport is divided up.
+	PeriodType Score_PeriodType `protobuf:"varint,6,opt,name=period_type,json=periodType,proto3,enum=sports.Score_PeriodType" json:"period_type,omitempty"`
+	// Periods is the number of periods in a game of the sport.
+	Periods int32 `protobuf:"varint,7,opt,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (x *SportType) Reset() {
+	*x = SportType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SportType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SportType) ProtoMessage() {}
+
+func (x *SportType) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SportType.ProtoReflect.Descriptor instead.
+func (*SportType) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SportType) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SportType) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SportType) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *SportType) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *SportType) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *SportType) GetPeriodType() Score_PeriodType {
+	if x != nil {
+		return x.PeriodType
+	}
+	return Score_PERIOD_TYPE_UNSPECIFIED
+}
+
+func (x *SportType) GetPeriods() int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+// A competition resource, e.g. the NBA.
+type Competition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID represents a unique identifier for the competition.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name is the name of the competition.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Sport is the sport played in the competition.
+	Sport string `protobuf:"bytes,3,opt,name=sport,proto3" json:"sport,omitempty"`
+}
+
+func (x *Competition) Reset() {
+	*x = Competition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Competition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Competition) ProtoMessage() {}
+
+func (x *Competition) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Competition.ProtoReflect.Descriptor instead.
+func (*Competition) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Competition) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Competition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Competition) GetSport() string {
+	if x != nil {
+		return x.Sport
+	}
+	return ""
+}
+
+// A team resource.
+type Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID represents a unique identifier for the team.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// CompetitionID represents a unique identifier for the competition the team plays in.
+	CompetitionId int64 `protobuf:"varint,2,opt,name=competition_id,json=competitionId,proto3" json:"competition_id,omitempty"`
+	// Name is the name of the team.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Team) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Team) GetCompetitionId() int64 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The points scored in a single period of a sport event.
+type PeriodScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Period is the number of the period, starting from 1.
+	Period     int32 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	HomePoints int64 `protobuf:"varint,2,opt,name=home_points,json=homePoints,proto3" json:"home_points,omitempty"`
+	AwayPoints int64 `protobuf:"varint,3,opt,name=away_points,json=awayPoints,proto3" json:"away_points,omitempty"`
+}
+
+func (x *PeriodScore) Reset() {
+	*x = PeriodScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeriodScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodScore) ProtoMessage() {}
+
+func (x *PeriodScore) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodScore.ProtoReflect.Descriptor instead.
+func (*PeriodScore) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PeriodScore) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *PeriodScore) GetHomePoints() int64 {
+	if x != nil {
+		return x.HomePoints
+	}
+	return 0
+}
+
+func (x *PeriodScore) GetAwayPoints() int64 {
+	if x != nil {
+		return x.AwayPoints
+	}
+	return 0
+}
+
+// A change to the score of a sport event.
+type ScoreUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HomeScore int64 `protobuf:"varint,1,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore int64 `protobuf:"varint,2,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	// UpdatedAt is when the score was recorded.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ScoreUpdate) Reset() {
+	*x = ScoreUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreUpdate) ProtoMessage() {}
+
+func (x *ScoreUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreUpdate.ProtoReflect.Descriptor instead.
+func (*ScoreUpdate) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ScoreUpdate) GetHomeScore() int64 {
+	if x != nil {
+		return x.HomeScore
+	}
+	return 0
+}
+
+func (x *ScoreUpdate) GetAwayScore() int64 {
+	if x != nil {
+		return x.AwayScore
+	}
+	return 0
+}
+
+func (x *ScoreUpdate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Request to Search
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Q is the text to search for. Every word has to match, and the last can be
+	// the start of a word.
+	Q string `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	// Count is the number of results to return, 20 by default and at most 100.
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Response to Search call.
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Results are ordered from the best match.
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// A sport event, competition or team matching a search.
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type SearchResult_Type `protobuf:"varint,1,opt,name=type,proto3,enum=sports.SearchResult_Type" json:"type,omitempty"`
+	// Id is the id of the sport event, competition or team.
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Name is the name matched.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Snippet is the name with the matching words wrapped in <b> and </b>.
+	Snippet string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// Score is how well the result matches the search, from 0 to 1 where higher is better. Each type
+	// of result is scored against the best match of the same type.
+	Score float64 `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	// Sport is the slug of the sport type the result is in.
+	Sport string `protobuf:"bytes,6,opt,name=sport,proto3" json:"sport,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SearchResult) GetType() SearchResult_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SearchResult_TYPE_UNSPECIFIED
+}
+
+func (x *SearchResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetSport() string {
+	if x != nil {
+		return x.Sport
+	}
+	return ""
+}
+
+// Request to BulkImport
+type BulkImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format ImportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=sports.ImportFormat" json:"format,omitempty"`
+	// Data is the contents of the file, a row for each sport event.
+	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// DryRun checks every row and reports what would be imported, without
+	// storing anything.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *BulkImportRequest) Reset() {
+	*x = BulkImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportRequest) ProtoMessage() {}
+
+func (x *BulkImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportRequest.ProtoReflect.Descriptor instead.
+func (*BulkImportRequest) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BulkImportRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *BulkImportRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *BulkImportRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// Response to BulkImport call.
+type BulkImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Created and Updated count the rows imported as new sport events and over
+	// the sport events with the same external ID. Failed counts the rows left out.
+	Created int32 `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int32 `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed  int32 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Errors lists every problem with the rows left out, in file order.
+	Errors []*ImportError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	// DryRun is set when nothing was stored.
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *BulkImportResponse) Reset() {
+	*x = BulkImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportResponse) ProtoMessage() {}
+
+func (x *BulkImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportResponse.ProtoReflect.Descriptor instead.
+func (*BulkImportResponse) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BulkImportResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BulkImportResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *BulkImportResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkImportResponse) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *BulkImportResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// A problem with a row of an imported file.
+type ImportError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Line is the line of the file the row starts on, counting from 1.
+	Line int32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// ExternalId is the external ID of the row, if it has one.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// Field is the column with the problem, empty when it is the whole row.
+	Field       string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ImportError) Reset() {
+	*x = ImportError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sports_sports_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportError) ProtoMessage() {}
+
+func (x *ImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_sports_sports_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportError.ProtoReflect.Descriptor instead.
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return file_sports_sports_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportError) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ImportError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportError) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_sports_sports_proto protoreflect.FileDescriptor
+
+var file_sports_sports_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5b,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x40, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x88, 0x01,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x06, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xb0, 0x04, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x12, 0x4e, 0x0a, 0x15, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73,
+	0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x13, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x4a, 0x0a, 0x13, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73,
+	0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x61,
+	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f,
+	0x12, 0x42, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x12,
+	0x2c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74,
+	0x4b, 0x65, 0x79, 0x52, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x36, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x44, 0x10, 0x02, 0x22, 0x33, 0x0a, 0x07, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x43, 0x0a, 0x17, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x44, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x44, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x60,
+	0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x48, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x6f, 0x6d, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x77, 0x61, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x2d, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0x6c,
+	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x2b, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x2d, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0a, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x58, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x53, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x63,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4a, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73,
+	0x22, 0x47, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x69, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e,
+	0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0xc1, 0x04, 0x0a, 0x0a, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x15, 0x61, 0x64, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x13, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f,
+	0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x68,
+	0x6f, 0x6d, 0x65, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0c, 0x61, 0x77, 0x61, 0x79, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12,
+	0x35, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x70, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x43, 0x48, 0x45,
+	0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x56, 0x45, 0x10,
+	0x02, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08,
+	0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x4f,
+	0x53, 0x54, 0x50, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x22, 0xd3, 0x02, 0x0a, 0x05, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x68, 0x6f, 0x6d,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x77, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74,
+	0x69, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x77, 0x61, 0x79, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x77, 0x61, 0x79,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x4c, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x17, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x51,
+	0x55, 0x41, 0x52, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x41, 0x4c, 0x46,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x03, 0x22, 0xa8,
+	0x02, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4a, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x48,
+	0x45, 0x41, 0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x4f, 0x54, 0x41, 0x4c,
+	0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x10, 0x03, 0x22, 0x62, 0x0a, 0x09, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xe0, 0x01,
+	0x0a, 0x09, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x22, 0x47, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x51, 0x0a, 0x04, 0x54, 0x65, 0x61,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x0b,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x77, 0x61, 0x79, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x77, 0x61, 0x79, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x6f, 0x6d, 0x65, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x77, 0x61, 0x79, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x33,
+	0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0c, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x40, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x48, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x43, 0x4f, 0x4d, 0x50, 0x45, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12,
+	0x08, 0x0a, 0x04, 0x54, 0x45, 0x41, 0x4d, 0x10, 0x03, 0x22, 0x6e, 0x0a, 0x11, 0x42, 0x75, 0x6c,
+	0x6b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x42, 0x75,
+	0x6c, 0x6b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x22, 0x7a, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x42,
+	0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d,
+	0x0a, 0x19, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e,
+	0x10, 0x02, 0x32, 0x94, 0x0a, 0x0a, 0x06, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x5f, 0x0a,
+	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f,
+	0x6c, 0x69, 0x73, 0x74, 0x2d, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x62,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b,
+	0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x70, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x05, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x7b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1e, 0x32, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f,
+	0x7b, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x69, 0x64, 0x7d, 0x3a, 0x05, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x88, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a, 0x0b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x23, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x68, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1d, 0x2e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x77, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x63,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x5b,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69,
+	0x73, 0x74, 0x2d, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x39, 0x0a, 0x06, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0a, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x42, 0x75,
+	0x6c, 0x6b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2d,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x42, 0x09, 0x5a, 0x07, 0x2f, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sports_sports_proto_rawDescOnce sync.Once
+	file_sports_sports_proto_rawDescData = file_sports_sports_proto_rawDesc
+)
+
+func file_sports_sports_proto_rawDescGZIP() []byte {
+	file_sports_sports_proto_rawDescOnce.Do(func() {
+		file_sports_sports_proto_rawDescData = protoimpl.X.CompressGZIP(file_sports_sports_proto_rawDescData)
+	})
+	return file_sports_sports_proto_rawDescData
+}
+
+var file_sports_sports_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_sports_sports_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_sports_sports_proto_goTypes = []interface{}{
+	(ImportFormat)(0),                     // 0: sports.ImportFormat
+	(ListSportsRequestFilter_Status)(0),   // 1: sports.ListSportsRequestFilter.Status
+	(SportEvent_Status)(0),                // 2: sports.sportEvent.Status
+	(Score_PeriodType)(0),                 // 3: sports.Score.PeriodType
+	(Market_Type)(0),                      // 4: sports.Market.Type
+	(SearchResult_Type)(0),                // 5: sports.SearchResult.Type
+	(*GetSportByIDRequest)(nil),           // 6: sports.GetSportByIDRequest
+	(*GetSportByIDResponse)(nil),          // 7: sports.GetSportByIDResponse
+	(*ListSportsRequest)(nil),             // 8: sports.ListSportsRequest
+	(*ListSportsResponse)(nil),            // 9: sports.ListSportsResponse
+	(*ListSportsRequestFilter)(nil),       // 10: sports.ListSportsRequestFilter
+	(*SortKey)(nil),                       // 11: sports.SortKey
+	(*CreateSportEventRequest)(nil),       // 12: sports.CreateSportEventRequest
+	(*CreateSportEventResponse)(nil),      // 13: sports.CreateSportEventResponse
+	(*UpdateSportEventRequest)(nil),       // 14: sports.UpdateSportEventRequest
+	(*UpdateSportEventResponse)(nil),      // 15: sports.UpdateSportEventResponse
+	(*TransitionSportEventRequest)(nil),   // 16: sports.TransitionSportEventRequest
+	(*TransitionSportEventResponse)(nil),  // 17: sports.TransitionSportEventResponse
+	(*UpdateScoreRequest)(nil),            // 18: sports.UpdateScoreRequest
+	(*UpdateScoreResponse)(nil),           // 19: sports.UpdateScoreResponse
+	(*GetSportEventMarketsRequest)(nil),   // 20: sports.GetSportEventMarketsRequest
+	(*GetSportEventMarketsResponse)(nil),  // 21: sports.GetSportEventMarketsResponse
+	(*ListSportTypesRequest)(nil),         // 22: sports.ListSportTypesRequest
+	(*ListSportTypesResponse)(nil),        // 23: sports.ListSportTypesResponse
+	(*ListCompetitionsRequest)(nil),       // 24: sports.ListCompetitionsRequest
+	(*ListCompetitionsResponse)(nil),      // 25: sports.ListCompetitionsResponse
+	(*ListTeamsRequest)(nil),              // 26: sports.ListTeamsRequest
+	(*ListTeamsResponse)(nil),             // 27: sports.ListTeamsResponse
+	(*ListCompetitionsRequestFilter)(nil), // 28: sports.ListCompetitionsRequestFilter
+	(*ListTeamsRequestFilter)(nil),        // 29: sports.ListTeamsRequestFilter
+	(*SportEvent)(nil),                    // 30: sports.sportEvent
+	(*Score)(nil),                         // 31: sports.Score
+	(*Market)(nil),                        // 32: sports.Market
+	(*Selection)(nil),                     // 33: sports.Selection
+	(*SportType)(nil),                     // 34: sports.SportType
+	(*Competition)(nil),                   // 35: sports.Competition
+	(*Team)(nil),                          // 36: sports.Team
+	(*PeriodScore)(nil),                   // 37: sports.PeriodScore
+	(*ScoreUpdate)(nil),                   // 38: sports.ScoreUpdate
+	(*SearchRequest)(nil),                 // 39: sports.SearchRequest
+	(*SearchResponse)(nil),                // 40: sports.SearchResponse
+	(*SearchResult)(nil),                  // 41: sports.SearchResult
+	(*BulkImportRequest)(nil),             // 42: sports.BulkImportRequest
+	(*BulkImportResponse)(nil),            // 43: sports.BulkImportResponse
+	(*ImportError)(nil),                   // 44: sports.ImportError
+	(*timestamppb.Timestamp)(nil),         // 45: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 46: google.protobuf.FieldMask
+}
+var file_sports_sports_proto_depIdxs = []int32{
+	30, // 0: sports.GetSportByIDResponse.sport:type_name -> sports.sportEvent
+	10, // 1: sports.ListSportsRequest.filter:type_name -> sports.ListSportsRequestFilter
+	30, // 2: sports.ListSportsResponse.sports:type_name -> sports.sportEvent
+	45, // 3: sports.ListSportsRequestFilter.advertised_start_from:type_name -> google.protobuf.Timestamp
+	45, // 4: sports.ListSportsRequestFilter.advertised_start_to:type_name -> google.protobuf.Timestamp
+	1,  // 5: sports.ListSportsRequestFilter.status:type_name -> sports.ListSportsRequestFilter.Status
+	2,  // 6: sports.ListSportsRequestFilter.statuses:type_name -> sports.sportEvent.Status
+	11, // 7: sports.ListSportsRequestFilter.sort_keys:type_name -> sports.SortKey
+	30, // 8: sports.CreateSportEventRequest.sport:type_name -> sports.sportEvent
+	30, // 9: sports.CreateSportEventResponse.sport:type_name -> sports.sportEvent
+	30, // 10: sports.UpdateSportEventRequest.sport:type_name -> sports.sportEvent
+	46, // 11: sports.UpdateSportEventRequest.update_mask:type_name -> google.protobuf.FieldMask
+	30, // 12: sports.UpdateSportEventResponse.sport:type_name -> sports.sportEvent
+	2,  // 13: sports.TransitionSportEventRequest.status:type_name -> sports.sportEvent.Status
+	30, // 14: sports.TransitionSportEventResponse.sport:type_name -> sports.sportEvent
+	37, // 15: sports.UpdateScoreRequest.periods:type_name -> sports.PeriodScore
+	30, // 16: sports.UpdateScoreResponse.sport:type_name -> sports.sportEvent
+	38, // 17: sports.UpdateScoreResponse.update:type_name -> sports.ScoreUpdate
+	32, // 18: sports.GetSportEventMarketsResponse.markets:type_name -> sports.Market
+	34, // 19: sports.ListSportTypesResponse.sport_types:type_name -> sports.SportType
+	28, // 20: sports.ListCompetitionsRequest.filter:type_name -> sports.ListCompetitionsRequestFilter
+	35, // 21: sports.ListCompetitionsResponse.competitions:type_name -> sports.Competition
+	29, // 22: sports.ListTeamsRequest.filter:type_name -> sports.ListTeamsRequestFilter
+	36, // 23: sports.ListTeamsResponse.teams:type_name -> sports.Team
+	45, // 24: sports.sportEvent.advertised_start_time:type_name -> google.protobuf.Timestamp
+	31, // 25: sports.sportEvent.score:type_name -> sports.Score
+	2,  // 26: sports.sportEvent.status:type_name -> sports.sportEvent.Status
+	32, // 27: sports.sportEvent.primary_market:type_name -> sports.Market
+	37, // 28: sports.Score.periods:type_name -> sports.PeriodScore
+	3,  // 29: sports.Score.period_type:type_name -> sports.Score.PeriodType
+	4,  // 30: sports.Market.type:type_name -> sports.Market.Type
+	33, // 31: sports.Market.selections:type_name -> sports.Selection
+	3,  // 32: sports.SportType.period_type:type_name -> sports.Score.PeriodType
+	45, // 33: sports.ScoreUpdate.updated_at:type_name -> google.protobuf.Timestamp
+	41, // 34: sports.SearchResponse.results:type_name -> sports.SearchResult
+	5,  // 35: sports.SearchResult.type:type_name -> sports.SearchResult.Type
+	0,  // 36: sports.BulkImportRequest.format:type_name -> sports.ImportFormat
+	44, // 37: sports.BulkImportResponse.errors:type_name -> sports.ImportError
+	8,  // 38: sports.Sports.ListSports:input_type -> sports.ListSportsRequest
+	6,  // 39: sports.Sports.GetSportByID:input_type -> sports.GetSportByIDRequest
+	12, // 40: sports.Sports.CreateSportEvent:input_type -> sports.CreateSportEventRequest
+	14, // 41: sports.Sports.UpdateSportEvent:input_type -> sports.UpdateSportEventRequest
+	16, // 42: sports.Sports.TransitionSportEvent:input_type -> sports.TransitionSportEventRequest
+	18, // 43: sports.Sports.UpdateScore:input_type -> sports.UpdateScoreRequest
+	20, // 44: sports.Sports.GetSportEventMarkets:input_type -> sports.GetSportEventMarketsRequest
+	22, // 45: sports.Sports.ListSportTypes:input_type -> sports.ListSportTypesRequest
+	24, // 46: sports.Sports.ListCompetitions:input_type -> sports.ListCompetitionsRequest
+	26, // 47: sports.Sports.ListTeams:input_type -> sports.ListTeamsRequest
+	39, // 48: sports.Sports.Search:input_type -> sports.SearchRequest
+	42, // 49: sports.Sports.BulkImport:input_type -> sports.BulkImportRequest
+	9,  // 50: sports.Sports.ListSports:output_type -> sports.ListSportsResponse
+	7,  // 51: sports.Sports.GetSportByID:output_type -> sports.GetSportByIDResponse
+	13, // 52: sports.Sports.CreateSportEvent:output_type -> sports.CreateSportEventResponse
+	15, // 53: sports.Sports.UpdateSportEvent:output_type -> sports.UpdateSportEventResponse
+	17, // 54: sports.Sports.TransitionSportEvent:output_type -> sports.TransitionSportEventResponse
+	19, // 55: sports.Sports.UpdateScore:output_type -> sports.UpdateScoreResponse
+	21, // 56: sports.Sports.GetSportEventMarkets:output_type -> sports.GetSportEventMarketsResponse
+	23, // 57: sports.Sports.ListSportTypes:output_type -> sports.ListSportTypesResponse
+	25, // 58: sports.Sports.ListCompetitions:output_type -> sports.ListCompetitionsResponse
+	27, // 59: sports.Sports.ListTeams:output_type -> sports.ListTeamsResponse
+	40, // 60: sports.Sports.Search:output_type -> sports.SearchResponse
+	43, // 61: sports.Sports.BulkImport:output_type -> sports.BulkImportResponse
+	50, // [50:62] is the sub-list for method output_type
+	38, // [38:50] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_sports_sports_proto_init() }
+func file_sports_sports_proto_init() {
 	if File_sports_sports_proto != nil {
 		return
 	}
@@ -487,8 +3514,116 @@ func file_sports_sports_proto_init() {
 				return nil
 			}
 		}
-		file_sports_sports_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSportByIDResponse); i {
+		file_sports_sports_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSportByIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSportsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSportsRequestFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSportEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSportEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSportEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSportEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitionSportEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -499,8 +3634,8 @@ func file_sports_sports_proto_init() {
 				return nil
 			}
 		}
-		file_sports_sports_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSportsRequest); i {
+		file_sports_sports_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitionSportEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -511,8 +3646,8 @@ func file_sports_sports_proto_init() {
 				return nil
 			}
 		}
-		file_sports_sports_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSportsResponse); i {
+		file_sports_sports_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateScoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -523,8 +3658,8 @@ func file_sports_sports_proto_init() {
 				return nil
 			}
 		}
-		file_sports_sports_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSportsRequestFilter); i {
+		file_sports_sports_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateScoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -535,7 +3670,127 @@ func file_sports_sports_proto_init() {
 				return nil
 			}
 		}
-		file_sports_sports_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_sports_sports_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSportEventMarketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSportEventMarketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSportTypesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSportTypesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCompetitionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCompetitionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTeamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTeamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCompetitionsRequestFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTeamsRequestFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SportEvent); i {
 			case 0:
 				return &v.state
@@ -547,19 +3802,188 @@ func file_sports_sports_proto_init() {
 				return nil
 			}
 		}
+		file_sports_sports_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Score); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Market); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Selection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SportType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Competition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeriodScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sports_sports_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sports_sports_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      6,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_sports_sports_proto_goTypes,
 		DependencyIndexes: file_sports_sports_proto_depIdxs,
+		EnumInfos:         file_sports_sports_proto_enumTypes,
 		MessageInfos:      file_sports_sports_proto_msgTypes,
 	}.Build()
 	File_sports_sports_proto = out.File
@@ -19,8 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Sports_ListSports_FullMethodName   = "/sports.Sports/ListSports"
-	Sports_GetSportByID_FullMethodName = "/sports.Sports/GetSportByID"
+	Sports_ListSports_FullMethodName           = "/sports.Sports/ListSports"
+	Sports_GetSportByID_FullMethodName         = "/sports.Sports/GetSportByID"
+	Sports_CreateSportEvent_FullMethodName     = "/sports.Sports/CreateSportEvent"
+	Sports_UpdateSportEvent_FullMethodName     = "/sports.Sports/UpdateSportEvent"
+	Sports_TransitionSportEvent_FullMethodName = "/sports.Sports/TransitionSportEvent"
+	Sports_UpdateScore_FullMethodName          = "/sports.Sports/UpdateScore"
+	Sports_GetSportEventMarkets_FullMethodName = "/sports.Sports/GetSportEventMarkets"
+	Sports_ListSportTypes_FullMethodName       = "/sports.Sports/ListSportTypes"
+	Sports_ListCompetitions_FullMethodName     = "/sports.Sports/ListCompetitions"
+	Sports_ListTeams_FullMethodName            = "/sports.Sports/ListTeams"
+	Sports_Search_FullMethodName               = "/sports.Sports/Search"
+	Sports_BulkImport_FullMethodName           = "/sports.Sports/BulkImport"
 )
 
 // SportsClient is the client API for Sports service.
@@ -31,6 +41,28 @@ type SportsClient interface {
 	ListSports(ctx context.Context, in *ListSportsRequest, opts ...grpc.CallOption) (*ListSportsResponse, error)
 	// GetSportByID returns the sport with the specified ID.
 	GetSportByID(ctx context.Context, in *GetSportByIDRequest, opts ...grpc.CallOption) (*GetSportByIDResponse, error)
+	// CreateSportEvent adds a new sport event.
+	CreateSportEvent(ctx context.Context, in *CreateSportEventRequest, opts ...grpc.CallOption) (*CreateSportEventResponse, error)
+	// UpdateSportEvent changes the fields of the sport event named in the update mask.
+	UpdateSportEvent(ctx context.Context, in *UpdateSportEventRequest, opts ...grpc.CallOption) (*UpdateSportEventResponse, error)
+	// TransitionSportEvent moves a sport event on to a new status.
+	TransitionSportEvent(ctx context.Context, in *TransitionSportEventRequest, opts ...grpc.CallOption) (*TransitionSportEventResponse, error)
+	// UpdateScore records the latest score of a sport event that is in play or finished.
+	UpdateScore(ctx context.Context, in *UpdateScoreRequest, opts ...grpc.CallOption) (*UpdateScoreResponse, error)
+	// GetSportEventMarkets returns the betting markets for the specified sport event.
+	GetSportEventMarkets(ctx context.Context, in *GetSportEventMarketsRequest, opts ...grpc.CallOption) (*GetSportEventMarketsResponse, error)
+	// ListSportTypes returns the catalogue of sports events can be played in.
+	ListSportTypes(ctx context.Context, in *ListSportTypesRequest, opts ...grpc.CallOption) (*ListSportTypesResponse, error)
+	// ListCompetitions returns a list of all competitions.
+	ListCompetitions(ctx context.Context, in *ListCompetitionsRequest, opts ...grpc.CallOption) (*ListCompetitionsResponse, error)
+	// ListTeams returns a list of all teams.
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	// Search returns the sport events, competitions and teams best matching the
+	// text. It is served by the gateway at GET /v1/search along with racing results.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// BulkImport creates or updates sport events by their external ID from a CSV
+	// or NDJSON file, reporting the rows it couldn't import.
+	BulkImport(ctx context.Context, in *BulkImportRequest, opts ...grpc.CallOption) (*BulkImportResponse, error)
 }
 
 type sportsClient struct {
@@ -59,6 +91,96 @@ func (c *sportsClient) GetSportByID(ctx context.Context, in *GetSportByIDRequest
 	return out, nil
 }
 
+func (c *sportsClient) CreateSportEvent(ctx context.Context, in *CreateSportEventRequest, opts ...grpc.CallOption) (*CreateSportEventResponse, error) {
+	out := new(CreateSportEventResponse)
+	err := c.cc.Invoke(ctx, Sports_CreateSportEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) UpdateSportEvent(ctx context.Context, in *UpdateSportEventRequest, opts ...grpc.CallOption) (*UpdateSportEventResponse, error) {
+	out := new(UpdateSportEventResponse)
+	err := c.cc.Invoke(ctx, Sports_UpdateSportEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) TransitionSportEvent(ctx context.Context, in *TransitionSportEventRequest, opts ...grpc.CallOption) (*TransitionSportEventResponse, error) {
+	out := new(TransitionSportEventResponse)
+	err := c.cc.Invoke(ctx, Sports_TransitionSportEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) UpdateScore(ctx context.Context, in *UpdateScoreRequest, opts ...grpc.CallOption) (*UpdateScoreResponse, error) {
+	out := new(UpdateScoreResponse)
+	err := c.cc.Invoke(ctx, Sports_UpdateScore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) GetSportEventMarkets(ctx context.Context, in *GetSportEventMarketsRequest, opts ...grpc.CallOption) (*GetSportEventMarketsResponse, error) {
+	out := new(GetSportEventMarketsResponse)
+	err := c.cc.Invoke(ctx, Sports_GetSportEventMarkets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListSportTypes(ctx context.Context, in *ListSportTypesRequest, opts ...grpc.CallOption) (*ListSportTypesResponse, error) {
+	out := new(ListSportTypesResponse)
+	err := c.cc.Invoke(ctx, Sports_ListSportTypes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListCompetitions(ctx context.Context, in *ListCompetitionsRequest, opts ...grpc.CallOption) (*ListCompetitionsResponse, error) {
+	out := new(ListCompetitionsResponse)
+	err := c.cc.Invoke(ctx, Sports_ListCompetitions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, Sports_ListTeams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, Sports_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) BulkImport(ctx context.Context, in *BulkImportRequest, opts ...grpc.CallOption) (*BulkImportResponse, error) {
+	out := new(BulkImportResponse)
+	err := c.cc.Invoke(ctx, Sports_BulkImport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SportsServer is the server API for Sports service.
 // All implementations must embed UnimplementedSportsServer
 // for forward compatibility
@@ -67,6 +189,28 @@ type SportsServer interface {
 	ListSports(context.Context, *ListSportsRequest) (*ListSportsResponse, error)
 	// GetSportByID returns the sport with the specified ID.
 	GetSportByID(context.Context, *GetSportByIDRequest) (*GetSportByIDResponse, error)
+	// CreateSportEvent adds a new sport event.
+	CreateSportEvent(context.Context, *CreateSportEventRequest) (*CreateSportEventResponse, error)
+	// UpdateSportEvent changes the fields of the sport event named in the update mask.
+	UpdateSportEvent(context.Context, *UpdateSportEventRequest) (*UpdateSportEventResponse, error)
+	// TransitionSportEvent moves a sport event on to a new status.
+	TransitionSportEvent(context.Context, *TransitionSportEventRequest) (*TransitionSportEventResponse, error)
+	// UpdateScore records the latest score of a sport event that is in play or finished.
+	UpdateScore(context.Context, *UpdateScoreRequest) (*UpdateScoreResponse, error)
+	// GetSportEventMarkets returns the betting markets for the specified sport event.
+	GetSportEventMarkets(context.Context, *GetSportEventMarketsRequest) (*GetSportEventMarketsResponse, error)
+	// ListSportTypes returns the catalogue of sports events can be played in.
+	ListSportTypes(context.Context, *ListSportTypesRequest) (*ListSportTypesResponse, error)
+	// ListCompetitions returns a list of all competitions.
+	ListCompetitions(context.Context, *ListCompetitionsRequest) (*ListCompetitionsResponse, error)
+	// ListTeams returns a list of all teams.
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	// Search returns the sport events, competitions and teams best matching the
+	// text. It is served by the gateway at GET /v1/search along with racing results.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// BulkImport creates or updates sport events by their external ID from a CSV
+	// or NDJSON file, reporting the rows it couldn't import.
+	BulkImport(context.Context, *BulkImportRequest) (*BulkImportResponse, error)
 	mustEmbedUnimplementedSportsServer()
 }
 
@@ -80,6 +224,36 @@ func (UnimplementedSportsServer) ListSports(context.Context, *ListSportsRequest)
 func (UnimplementedSportsServer) GetSportByID(context.Context, *GetSportByIDRequest) (*GetSportByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSportByID not implemented")
 }
+func (UnimplementedSportsServer) CreateSportEvent(context.Context, *CreateSportEventRequest) (*CreateSportEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSportEvent not implemented")
+}
+func (UnimplementedSportsServer) UpdateSportEvent(context.Context, *UpdateSportEventRequest) (*UpdateSportEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSportEvent not implemented")
+}
+func (UnimplementedSportsServer) TransitionSportEvent(context.Context, *TransitionSportEventRequest) (*TransitionSportEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionSportEvent not implemented")
+}
+func (UnimplementedSportsServer) UpdateScore(context.Context, *UpdateScoreRequest) (*UpdateScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScore not implemented")
+}
+func (UnimplementedSportsServer) GetSportEventMarkets(context.Context, *GetSportEventMarketsRequest) (*GetSportEventMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSportEventMarkets not implemented")
+}
+func (UnimplementedSportsServer) ListSportTypes(context.Context, *ListSportTypesRequest) (*ListSportTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSportTypes not implemented")
+}
+func (UnimplementedSportsServer) ListCompetitions(context.Context, *ListCompetitionsRequest) (*ListCompetitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompetitions not implemented")
+}
+func (UnimplementedSportsServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedSportsServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedSportsServer) BulkImport(context.Context, *BulkImportRequest) (*BulkImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkImport not implemented")
+}
 func (UnimplementedSportsServer) mustEmbedUnimplementedSportsServer() {}
 
 // UnsafeSportsServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +303,186 @@ func _Sports_GetSportByID_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sports_CreateSportEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSportEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).CreateSportEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_CreateSportEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).CreateSportEvent(ctx, req.(*CreateSportEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_UpdateSportEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSportEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).UpdateSportEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_UpdateSportEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).UpdateSportEvent(ctx, req.(*UpdateSportEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_TransitionSportEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionSportEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).TransitionSportEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_TransitionSportEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).TransitionSportEvent(ctx, req.(*TransitionSportEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_UpdateScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).UpdateScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_UpdateScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).UpdateScore(ctx, req.(*UpdateScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_GetSportEventMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSportEventMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetSportEventMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_GetSportEventMarkets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetSportEventMarkets(ctx, req.(*GetSportEventMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListSportTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSportTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListSportTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_ListSportTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListSportTypes(ctx, req.(*ListSportTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListCompetitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompetitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListCompetitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_ListCompetitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListCompetitions(ctx, req.(*ListCompetitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_BulkImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).BulkImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_BulkImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).BulkImport(ctx, req.(*BulkImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Sports_ServiceDesc is the grpc.ServiceDesc for Sports service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +498,46 @@ var Sports_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSportByID",
 			Handler:    _Sports_GetSportByID_Handler,
 		},
+		{
+			MethodName: "CreateSportEvent",
+			Handler:    _Sports_CreateSportEvent_Handler,
+		},
+		{
+			MethodName: "UpdateSportEvent",
+			Handler:    _Sports_UpdateSportEvent_Handler,
+		},
+		{
+			MethodName: "TransitionSportEvent",
+			Handler:    _Sports_TransitionSportEvent_Handler,
+		},
+		{
+			MethodName: "UpdateScore",
+			Handler:    _Sports_UpdateScore_Handler,
+		},
+		{
+			MethodName: "GetSportEventMarkets",
+			Handler:    _Sports_GetSportEventMarkets_Handler,
+		},
+		{
+			MethodName: "ListSportTypes",
+			Handler:    _Sports_ListSportTypes_Handler,
+		},
+		{
+			MethodName: "ListCompetitions",
+			Handler:    _Sports_ListCompetitions_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _Sports_ListTeams_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Sports_Search_Handler,
+		},
+		{
+			MethodName: "BulkImport",
+			Handler:    _Sports_BulkImport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sports/sports.proto",
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sibeyzoran/EntainGroupTest/api/proto/racing"
+	"github.com/sibeyzoran/EntainGroupTest/api/proto/sports"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultSearchCount is how many results /v1/search returns when no count is given, in step
+// with the default of the racing and sports Search RPCs.
+const defaultSearchCount = 20
+
+// A search result from either service, marshalled ready to be written out
+type searchResult struct {
+	score float64
+	json  json.RawMessage
+}
+
+// Handles GET /v1/search?q=, searching racing and sports at the same time and merging their
+// results by score. An optional count limits how many results come back. When one service can't
+// search, because it is down or was built without FTS5, the other's results are still returned.
+func searchHandler(mux *runtime.ServeMux, racingClient racing.RacingClient, sportsClient sports.SportsClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx := r.Context()
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+
+		count := defaultSearchCount
+		if value := r.URL.Query().Get("count"); value != "" {
+			parsed, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "invalid count: %q is not a number", value))
+				return
+			}
+			if parsed > 0 {
+				count = int(parsed)
+			}
+		}
+		q := r.URL.Query().Get("q")
+
+		var (
+			wait           sync.WaitGroup
+			racingResponse *racing.SearchResponse
+			sportsResponse *sports.SearchResponse
+			racingErr      error
+			sportsErr      error
+		)
+		wait.Add(2)
+		go func() {
+			defer wait.Done()
+			racingResponse, racingErr = racingClient.Search(ctx, &racing.SearchRequest{Q: q, Count: int32(count)})
+		}()
+		go func() {
+			defer wait.Done()
+			sportsResponse, sportsErr = sportsClient.Search(ctx, &sports.SearchRequest{Q: q, Count: int32(count)})
+		}()
+		wait.Wait()
+
+		// Carry on with one service's results when the other can't search, unless neither can
+		var failed error
+		switch {
+		case searchSkipped(racingErr) && searchSkipped(sportsErr):
+			failed = racingErr
+		case racingErr != nil && !searchSkipped(racingErr):
+			failed = racingErr
+		case sportsErr != nil && !searchSkipped(sportsErr):
+			failed = sportsErr
+		}
+		if failed != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, failed)
+			return
+		}
+		if racingErr != nil {
+			log.Printf("searching sports only, racing can't search: %s\n", racingErr)
+		}
+		if sportsErr != nil {
+			log.Printf("searching racing only, sports can't search: %s\n", sportsErr)
+		}
+
+		// Scores from the two services' indexes can't be compared, so each is scaled against the
+		// best match from the same service before they are merged
+		var results []searchResult
+		racingScores := make([]float64, len(racingResponse.GetResults()))
+		for i, result := range racingResponse.GetResults() {
+			racingScores[i] = result.Score
+		}
+		racingScale := searchScale(racingScores)
+		for _, result := range racingResponse.GetResults() {
+			result.Score *= racingScale
+			encoded, err := marshaler.Marshal(result)
+			if err != nil {
+				runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+				return
+			}
+			results = append(results, searchResult{result.Score, encoded})
+		}
+		sportsScores := make([]float64, len(sportsResponse.GetResults()))
+		for i, result := range sportsResponse.GetResults() {
+			sportsScores[i] = result.Score
+		}
+		sportsScale := searchScale(sportsScores)
+		for _, result := range sportsResponse.GetResults() {
+			result.Score *= sportsScale
+			encoded, err := marshaler.Marshal(result)
+			if err != nil {
+				runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+				return
+			}
+			results = append(results, searchResult{result.Score, encoded})
+		}
+
+		// Each service has already ordered its own results, so only the merge needs sorting
+		sort.SliceStable(results, func(i, j int) bool { return results[i].score > results[j].score })
+		if len(results) > count {
+			results = results[:count]
+		}
+
+		encoded := make([]json.RawMessage, len(results))
+		for i, result := range results {
+			encoded[i] = result.json
+		}
+		body, err := json.Marshal(struct {
+			Results []json.RawMessage `json:"results"`
+		}{encoded})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", marshaler.ContentType(nil))
+		w.Write(body)
+	}
+}
+
+// Reports whether a service's search failed in a way the other's results can be returned without
+func searchSkipped(err error) bool {
+	code := status.Code(err)
+	return code == codes.Unimplemented || code == codes.Unavailable
+}
+
+// Works out what to multiply the scores from one service by so its best match scores 1
+func searchScale(scores []float64) float64 {
+	var best float64
+	for _, score := range scores {
+		if score > best {
+			best = score
+		}
+	}
+	if best <= 0 {
+		return 1
+	}
+
+	return 1 / best
+}
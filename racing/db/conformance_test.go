@@ -0,0 +1,616 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// The databases races can be stored in. Every one of them has to behave the same way, so the
+// conformance tests run against each.
+var testBackends = []struct {
+	name    string
+	newRepo func(t *testing.T) *racesRepo
+}{
+	{"sqlite", newSQLiteRacesRepo},
+	{"postgres", newPostgresRacesRepo},
+}
+
+// The tests every backend has to pass, run in order against the same repository. The ones
+// writing races come last, so the others see the races as seeded.
+var conformanceTests = []struct {
+	name string
+	test func(t *testing.T, repo *racesRepo)
+}{
+	{"seeded", testSeeded},
+	{"filter", testFilter},
+	{"order", testOrder},
+	{"pages", testPages},
+	{"page tokens", testPageTokens},
+	{"meeting dates", testMeetingDates},
+	{"search", testSearch},
+	{"create", testCreate},
+	{"visible", testVisible},
+	{"update", testUpdate},
+	{"delete", testDelete},
+}
+
+func TestConformance(t *testing.T) {
+	for _, backend := range testBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			repo := backend.newRepo(t)
+
+			for _, conformance := range conformanceTests {
+				t.Run(conformance.name, func(t *testing.T) {
+					conformance.test(t, repo)
+				})
+			}
+		})
+	}
+}
+
+// Lists every race, failing the test if they can't be
+func listRaces(t *testing.T, repo *racesRepo, filter *racing.ListRacesRequestFilter) []*racing.Race {
+	t.Helper()
+
+	races, err := repo.List(context.Background(), filter)
+	if err != nil {
+		t.Fatalf("List(%v) returned error %s", filter, err)
+	}
+
+	return races
+}
+
+// Returns the IDs of races in the order given
+func raceIDs(races []*racing.Race) []int64 {
+	ids := make([]int64, len(races))
+	for i, race := range races {
+		ids[i] = race.Id
+	}
+
+	return ids
+}
+
+// Checks the races listed are the ones wanted, in the same order
+func checkRaceIDs(t *testing.T, name string, got []*racing.Race, want []int64) {
+	t.Helper()
+
+	gotIDs := raceIDs(got)
+	if len(gotIDs) != len(want) {
+		t.Fatalf("%s returned races %v, want %v", name, gotIDs, want)
+	}
+	for i := range want {
+		if gotIDs[i] != want[i] {
+			t.Fatalf("%s returned races %v, want %v", name, gotIDs, want)
+		}
+	}
+}
+
+func testSeeded(t *testing.T, repo *racesRepo) {
+	races := listRaces(t, repo, nil)
+	if len(races) != testSeedOptions().Races {
+		t.Fatalf("List() returned %d races, want %d", len(races), testSeedOptions().Races)
+	}
+
+	var visible int
+	for _, race := range races {
+		if race.Visible {
+			visible++
+		}
+		if race.Status == racing.Race_STATUS_UNSPECIFIED {
+			t.Errorf("race %d was seeded without a status", race.Id)
+		}
+	}
+	if visible == 0 || visible == len(races) {
+		t.Errorf("%d of %d races were seeded visible, want some of each", visible, len(races))
+	}
+}
+
+func testFilter(t *testing.T, repo *racesRepo) {
+	races := listRaces(t, repo, nil)
+
+	meetings, err := repo.ListMeetings(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListMeetings() returned error %s", err)
+	}
+	meetingsByID := make(map[int64]*racing.Meeting)
+	for _, meeting := range meetings {
+		meetingsByID[meeting.Id] = meeting
+	}
+
+	now := testSeedOptions().Now
+	from, to := now, now.Add(24*time.Hour)
+	first := meetingsByID[races[0].MeetingId]
+
+	tests := []struct {
+		name   string
+		filter *racing.ListRacesRequestFilter
+		want   func(race *racing.Race) bool
+	}{
+		{
+			name:   "meeting ids",
+			filter: &racing.ListRacesRequestFilter{MeetingIds: []int64{1, 3}},
+			want:   func(race *racing.Race) bool { return race.MeetingId == 1 || race.MeetingId == 3 },
+		},
+		{
+			name:   "visible only",
+			filter: &racing.ListRacesRequestFilter{VisibleOnly: true},
+			want:   func(race *racing.Race) bool { return race.Visible },
+		},
+		{
+			name:   "status",
+			filter: &racing.ListRacesRequestFilter{Status: racing.Race_FINAL},
+			want:   func(race *racing.Race) bool { return race.Status == racing.Race_FINAL },
+		},
+		{
+			name: "advertised start window",
+			filter: &racing.ListRacesRequestFilter{
+				AdvertisedStartFrom: timestamppb.New(from),
+				AdvertisedStartTo:   timestamppb.New(to),
+			},
+			want: func(race *racing.Race) bool {
+				start := race.AdvertisedStartTime.AsTime()
+				return !start.Before(from) && start.Before(to)
+			},
+		},
+		{
+			name:   "meeting category",
+			filter: &racing.ListRacesRequestFilter{MeetingCategories: []racing.Meeting_Category{first.Category}},
+			want:   func(race *racing.Race) bool { return meetingsByID[race.MeetingId].Category == first.Category },
+		},
+		{
+			name:   "meeting date",
+			filter: &racing.ListRacesRequestFilter{MeetingDate: first.Date},
+			want:   func(race *racing.Race) bool { return meetingsByID[race.MeetingId].Date == first.Date },
+		},
+		{
+			name:   "meeting country in any case",
+			filter: &racing.ListRacesRequestFilter{MeetingCountries: []string{"nzl"}},
+			want:   func(race *racing.Race) bool { return meetingsByID[race.MeetingId].Country == "NZL" },
+		},
+		{
+			name: "several at once",
+			filter: &racing.ListRacesRequestFilter{
+				MeetingIds:          []int64{1, 2, 3},
+				VisibleOnly:         true,
+				AdvertisedStartFrom: timestamppb.New(from),
+			},
+			want: func(race *racing.Race) bool {
+				return race.MeetingId <= 3 && race.Visible && !race.AdvertisedStartTime.AsTime().Before(from)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var want []int64
+			for _, race := range races {
+				if test.want(race) {
+					want = append(want, race.Id)
+				}
+			}
+
+			checkRaceIDs(t, "List()", listRaces(t, repo, test.filter), want)
+		})
+	}
+}
+
+// Compares two races by a field they can be ordered by, returning a negative number when a comes first
+func compareRaces(a, b *racing.Race, field string) int {
+	switch field {
+	case "name":
+		return compareValues(a.Name < b.Name, a.Name > b.Name)
+	case "number":
+		return compareValues(a.Number < b.Number, a.Number > b.Number)
+	case "meeting_id":
+		return compareValues(a.MeetingId < b.MeetingId, a.MeetingId > b.MeetingId)
+	case "visible":
+		return compareValues(!a.Visible && b.Visible, a.Visible && !b.Visible)
+	case "advertised_start_time":
+		aStart, bStart := a.AdvertisedStartTime.AsTime(), b.AdvertisedStartTime.AsTime()
+		return compareValues(aStart.Before(bStart), aStart.After(bStart))
+	default:
+		return compareValues(a.Id < b.Id, a.Id > b.Id)
+	}
+}
+
+func compareValues(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Orders a copy of the races by the sort keys given, with the ID breaking any ties
+func sortRaces(races []*racing.Race, keys []*racing.SortKey) []int64 {
+	sorted := append([]*racing.Race(nil), races...)
+	sort.Slice(sorted, func(i, j int) bool {
+		for _, key := range keys {
+			c := compareRaces(sorted[i], sorted[j], key.Field)
+			if key.Sort == "desc" {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	return raceIDs(sorted)
+}
+
+// Orderings races are listed and paged through in
+var testOrderings = []struct {
+	name   string
+	filter *racing.ListRacesRequestFilter
+	keys   []*racing.SortKey
+}{
+	{
+		name: "default",
+		keys: []*racing.SortKey{{Field: "advertised_start_time"}},
+	},
+	{
+		name:   "orderBy name",
+		filter: &racing.ListRacesRequestFilter{OrderBy: "name"},
+		keys:   []*racing.SortKey{{Field: "name"}},
+	},
+	{
+		name:   "orderBy number desc",
+		filter: &racing.ListRacesRequestFilter{OrderBy: "number", Sort: "desc"},
+		keys:   []*racing.SortKey{{Field: "number", Sort: "desc"}},
+	},
+	{
+		name:   "orderBy visible desc",
+		filter: &racing.ListRacesRequestFilter{OrderBy: "visible", Sort: "desc"},
+		keys:   []*racing.SortKey{{Field: "visible", Sort: "desc"}},
+	},
+	{
+		name:   "orderBy id desc",
+		filter: &racing.ListRacesRequestFilter{OrderBy: "id", Sort: "desc"},
+		keys:   []*racing.SortKey{{Field: "id", Sort: "desc"}},
+	},
+	{
+		name: "sort keys",
+		filter: &racing.ListRacesRequestFilter{SortKeys: []*racing.SortKey{
+			{Field: "meeting_id"},
+			{Field: "visible", Sort: "desc"},
+			{Field: "advertised_start_time", Sort: "desc"},
+		}},
+		keys: []*racing.SortKey{
+			{Field: "meeting_id"},
+			{Field: "visible", Sort: "desc"},
+			{Field: "advertised_start_time", Sort: "desc"},
+		},
+	},
+}
+
+func testOrder(t *testing.T, repo *racesRepo) {
+	races := listRaces(t, repo, nil)
+
+	for _, ordering := range testOrderings {
+		t.Run(ordering.name, func(t *testing.T) {
+			checkRaceIDs(t, "List()", listRaces(t, repo, ordering.filter), sortRaces(races, ordering.keys))
+		})
+	}
+
+	invalid := []struct {
+		name   string
+		filter *racing.ListRacesRequestFilter
+		field  string
+	}{
+		{"unknown orderBy", &racing.ListRacesRequestFilter{OrderBy: "venue"}, "filter.orderBy"},
+		{"unknown sort", &racing.ListRacesRequestFilter{OrderBy: "name", Sort: "up"}, "filter.sort"},
+		{"repeated sort key", &racing.ListRacesRequestFilter{SortKeys: []*racing.SortKey{{Field: "name"}, {Field: "name"}}}, "filter.sortKeys[1].field"},
+		{"sort keys with orderBy", &racing.ListRacesRequestFilter{OrderBy: "name", SortKeys: []*racing.SortKey{{Field: "name"}}}, "filter.sortKeys"},
+	}
+
+	for _, test := range invalid {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := repo.List(context.Background(), test.filter)
+
+			var fieldErr *InvalidFieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != test.field {
+				t.Fatalf("List() returned error %v, want an invalid %s", err, test.field)
+			}
+		})
+	}
+}
+
+func testPages(t *testing.T, repo *racesRepo) {
+	for _, ordering := range testOrderings {
+		for _, pageSize := range []int32{1, 3, 7, 20, 50} {
+			t.Run(ordering.name, func(t *testing.T) {
+				want := raceIDs(listRaces(t, repo, ordering.filter))
+
+				var (
+					paged     []*racing.Race
+					pageToken string
+				)
+				for pages := 1; ; pages++ {
+					if pages > len(want)+1 {
+						t.Fatalf("paging %d at a time didn't finish after %d pages", pageSize, pages-1)
+					}
+
+					page, next, err := repo.ListPage(context.Background(), ordering.filter, pageSize, pageToken)
+					if err != nil {
+						t.Fatalf("ListPage() returned error %s", err)
+					}
+					if len(page) > int(pageSize) {
+						t.Fatalf("ListPage() returned %d races, more than the page size of %d", len(page), pageSize)
+					}
+
+					paged = append(paged, page...)
+					if next == "" {
+						break
+					}
+					pageToken = next
+				}
+
+				checkRaceIDs(t, "paging through ListPage()", paged, want)
+			})
+		}
+	}
+}
+
+func testPageTokens(t *testing.T, repo *racesRepo) {
+	byName := &racing.ListRacesRequestFilter{OrderBy: "name"}
+	_, token, err := repo.ListPage(context.Background(), byName, 5, "")
+	if err != nil {
+		t.Fatalf("ListPage() returned error %s", err)
+	}
+
+	tests := []struct {
+		name   string
+		filter *racing.ListRacesRequestFilter
+		token  string
+	}{
+		{"not a token", byName, "not a token"},
+		{"issued for another ordering", &racing.ListRacesRequestFilter{OrderBy: "number"}, token},
+		{"issued for another direction", &racing.ListRacesRequestFilter{OrderBy: "name", Sort: "desc"}, token},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, _, err := repo.ListPage(context.Background(), test.filter, 5, test.token); !errors.Is(err, ErrInvalidPageToken) {
+				t.Fatalf("ListPage() returned error %v, want %v", err, ErrInvalidPageToken)
+			}
+		})
+	}
+}
+
+func testMeetingDates(t *testing.T, repo *racesRepo) {
+	meetings, err := repo.ListMeetings(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListMeetings() returned error %s", err)
+	}
+	if len(meetings) != testSeedOptions().Meetings {
+		t.Fatalf("ListMeetings() returned %d meetings, want %d", len(meetings), testSeedOptions().Meetings)
+	}
+
+	// Meetings are dated on the UTC day of their first race, or the day seeded around without any
+	firstStarts := make(map[int64]time.Time)
+	for _, race := range listRaces(t, repo, nil) {
+		start := race.AdvertisedStartTime.AsTime()
+		if first, ok := firstStarts[race.MeetingId]; !ok || start.Before(first) {
+			firstStarts[race.MeetingId] = start
+		}
+	}
+
+	for _, meeting := range meetings {
+		want := testSeedOptions().Now.UTC().Format("2006-01-02")
+		if first, ok := firstStarts[meeting.Id]; ok {
+			want = first.UTC().Format("2006-01-02")
+		}
+		if meeting.Date != want {
+			t.Errorf("meeting %d is dated %s, want %s", meeting.Id, meeting.Date, want)
+		}
+	}
+}
+
+func testSearch(t *testing.T, repo *racesRepo) {
+	if !repo.searchable {
+		if _, err := repo.Search(context.Background(), "race", 10); !errors.Is(err, ErrSearchUnavailable) {
+			t.Fatalf("Search() returned error %v, want %v", err, ErrSearchUnavailable)
+		}
+		t.Skip("search is unavailable, SQLite has to be built with -tags sqlite_fts5")
+	}
+
+	race, err := repo.GetByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetByID() returned error %s", err)
+	}
+
+	// The last word is matched as the start of a word, as it is while the name is being typed
+	text := race.Name[:len(race.Name)-1]
+	results, err := repo.Search(context.Background(), text, 10)
+	if err != nil {
+		t.Fatalf("Search(%q) returned error %s", text, err)
+	}
+
+	var found bool
+	for i, result := range results {
+		// Each kind of result is scored against the best of its kind, which scores 1
+		if result.Score <= 0 || result.Score > 1 {
+			t.Errorf("Search(%q) scored %s %d %f, want more than 0 and at most 1", text, result.Type, result.Id, result.Score)
+		}
+		if i > 0 && result.Score > results[i-1].Score {
+			t.Errorf("Search(%q) returned a score of %f after %f, want the best first", text, result.Score, results[i-1].Score)
+		}
+		if result.Type == racing.SearchResult_RACE && result.Id == race.Id {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("Search(%q) returned %v, want race %d among them", text, results, race.Id)
+	}
+	if results[0].Score != 1 {
+		t.Errorf("Search(%q) scored its best match %f, want 1", text, results[0].Score)
+	}
+}
+
+// Writes a race for the tests that change them
+func createTestRace(t *testing.T, repo *racesRepo, visible bool) *racing.Race {
+	t.Helper()
+
+	race, err := repo.Create(context.Background(), &racing.Race{
+		MeetingId:           2,
+		Name:                "Conformance Stakes",
+		Number:              9,
+		Visible:             visible,
+		AdvertisedStartTime: timestamppb.New(testSeedOptions().Now.Add(90 * time.Minute)),
+	})
+	if err != nil {
+		t.Fatalf("Create() returned error %s", err)
+	}
+
+	return race
+}
+
+func testCreate(t *testing.T, repo *racesRepo) {
+	race := createTestRace(t, repo, true)
+
+	// Races are seeded with their IDs, so new ones have to be numbered after them
+	if race.Id <= int64(testSeedOptions().Races) {
+		t.Errorf("Create() gave the race ID %d, want one after the %d seeded", race.Id, testSeedOptions().Races)
+	}
+	if race.Version != 1 {
+		t.Errorf("Create() gave the race version %d, want 1", race.Version)
+	}
+	if race.Name != "Conformance Stakes" || race.MeetingId != 2 || race.Number != 9 {
+		t.Errorf("Create() stored race %v", race)
+	}
+	if want := testSeedOptions().Now.Add(90 * time.Minute); !race.AdvertisedStartTime.AsTime().Equal(want) {
+		t.Errorf("Create() stored an advertised start time of %s, want %s", race.AdvertisedStartTime.AsTime(), want)
+	}
+
+	got, err := repo.GetByID(context.Background(), race.Id)
+	if err != nil {
+		t.Fatalf("GetByID(%d) returned error %s", race.Id, err)
+	}
+	if got.Name != race.Name || got.Version != race.Version {
+		t.Errorf("GetByID(%d) returned %v, want %v", race.Id, got, race)
+	}
+
+	another := createTestRace(t, repo, true)
+	if another.Id <= race.Id {
+		t.Errorf("Create() gave the next race ID %d, want one after %d", another.Id, race.Id)
+	}
+}
+
+func testVisible(t *testing.T, repo *racesRepo) {
+	for _, visible := range []bool{true, false} {
+		race := createTestRace(t, repo, visible)
+		if race.Visible != visible {
+			t.Errorf("Create() stored visible as %t, want %t", race.Visible, visible)
+		}
+
+		var listed bool
+		for _, other := range listRaces(t, repo, &racing.ListRacesRequestFilter{VisibleOnly: true}) {
+			listed = listed || other.Id == race.Id
+		}
+		if listed != visible {
+			t.Errorf("List() with visible_only listed a race stored as visible %t", visible)
+		}
+
+		race.Visible = !visible
+		updated, err := repo.Update(context.Background(), race, []string{"visible"})
+		if err != nil {
+			t.Fatalf("Update() returned error %s", err)
+		}
+		if updated.Visible != !visible {
+			t.Errorf("Update() stored visible as %t, want %t", updated.Visible, !visible)
+		}
+	}
+}
+
+func testUpdate(t *testing.T, repo *racesRepo) {
+	race := createTestRace(t, repo, false)
+
+	race.Name = "Conformance Cup"
+	race.Number = 3
+	updated, err := repo.Update(context.Background(), race, []string{"name"})
+	if err != nil {
+		t.Fatalf("Update() returned error %s", err)
+	}
+	if updated.Name != "Conformance Cup" || updated.Number != 9 {
+		t.Errorf("Update() of the name stored %q number %d, want %q number 9", updated.Name, updated.Number, "Conformance Cup")
+	}
+	if updated.Version != race.Version+1 {
+		t.Errorf("Update() moved the version on to %d, want %d", updated.Version, race.Version+1)
+	}
+
+	// The race has moved on from the version it was read at
+	if _, err := repo.Update(context.Background(), race, []string{"number"}); !errors.Is(err, ErrVersionConflict) {
+		t.Errorf("Update() of an old version returned error %v, want %v", err, ErrVersionConflict)
+	}
+
+	// A version of zero skips the check
+	unchecked := &racing.Race{Id: race.Id, Number: 4}
+	updated, err = repo.Update(context.Background(), unchecked, []string{"number"})
+	if err != nil {
+		t.Fatalf("Update() without a version returned error %s", err)
+	}
+	if updated.Number != 4 || updated.Version != race.Version+2 {
+		t.Errorf("Update() without a version stored number %d version %d, want number 4 version %d", updated.Number, updated.Version, race.Version+2)
+	}
+
+	missing := &racing.Race{Id: 1 << 40, Name: "Nowhere"}
+	if _, err := repo.Update(context.Background(), missing, []string{"name"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Update() of a race that doesn't exist returned error %v, want %v", err, ErrNotFound)
+	}
+
+	var fieldErr *InvalidFieldError
+	if _, err := repo.Update(context.Background(), race, []string{"status"}); !errors.As(err, &fieldErr) {
+		t.Errorf("Update() of the status returned error %v, want an invalid update_mask", err)
+	}
+}
+
+func testDelete(t *testing.T, repo *racesRepo) {
+	race := createTestRace(t, repo, true)
+
+	race.Name = "Conformance Plate"
+	updated, err := repo.Update(context.Background(), race, []string{"name"})
+	if err != nil {
+		t.Fatalf("Update() returned error %s", err)
+	}
+
+	if err := repo.Delete(context.Background(), race.Id, race.Version); !errors.Is(err, ErrVersionConflict) {
+		t.Errorf("Delete() of an old version returned error %v, want %v", err, ErrVersionConflict)
+	}
+	if err := repo.Delete(context.Background(), race.Id, updated.Version); err != nil {
+		t.Fatalf("Delete() returned error %s", err)
+	}
+
+	if _, err := repo.GetByID(context.Background(), race.Id); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByID() of a deleted race returned error %v, want %v", err, ErrNotFound)
+	}
+	if err := repo.Delete(context.Background(), race.Id, 0); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Delete() of a deleted race returned error %v, want %v", err, ErrNotFound)
+	}
+
+	// Everything recorded against a seeded race goes with it
+	runners, err := repo.ListRunners(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("ListRunners() returned error %s", err)
+	}
+	if len(runners) == 0 {
+		t.Fatalf("race 1 was seeded without runners")
+	}
+	if err := repo.Delete(context.Background(), 1, 0); err != nil {
+		t.Fatalf("Delete() returned error %s", err)
+	}
+	if runners, err := repo.ListRunners(context.Background(), 1); err != nil || len(runners) != 0 {
+		t.Errorf("ListRunners() of a deleted race returned %d runners and error %v, want none", len(runners), err)
+	}
+}
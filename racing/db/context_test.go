@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+func TestListStopsWithTheContext(t *testing.T) {
+	repo := newSQLiteRacesRepo(t)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{"canceled", canceled, context.Canceled},
+		{"past its deadline", expired, context.DeadlineExceeded},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			races, err := repo.List(test.ctx, nil)
+			if !errors.Is(err, test.want) {
+				t.Fatalf("List() returned %d races and error %v, want %v", len(races), err, test.want)
+			}
+		})
+	}
+}
+
+func TestListStopsARunningQuery(t *testing.T) {
+	repo := newTestRacesRepo(t, openSQLite(t, heldDriver))
+
+	// Filtering by start time runs strftime, which the held driver holds up
+	filter := &racing.ListRacesRequestFilter{AdvertisedStartFrom: timestamppb.New(testSeedOptions().Now)}
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		cancel  bool
+		want    error
+	}{
+		{name: "canceled", timeout: time.Minute, cancel: true, want: context.Canceled},
+		{name: "past its deadline", timeout: 50 * time.Millisecond, want: context.DeadlineExceeded},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hold := holdQuery(t)
+			ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+			defer cancel()
+
+			go func() {
+				<-hold.started
+				if test.cancel {
+					cancel()
+				}
+				<-ctx.Done()
+				// Give the driver time to interrupt the query before it can carry on
+				time.Sleep(10 * time.Millisecond)
+				hold.Release()
+			}()
+
+			races, err := repo.List(ctx, filter)
+
+			select {
+			case <-hold.started:
+			default:
+				t.Fatalf("List() didn't run a query the held driver could hold up")
+			}
+			if !errors.Is(err, test.want) {
+				t.Fatalf("List() returned %d races and error %v, want %v", len(races), err, test.want)
+			}
+		})
+	}
+}
@@ -1,69 +1,552 @@
 package db
 
 import (
+	"database/sql"
 	"fmt"
 	"math/rand"
+	"sort"
+	"strings"
 	"time"
 
 	"syreclabs.com/go/faker"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
 )
 
-// Possible Sports for seeding into DB Table
-var sportsData = []string{"basketball", "soccer", "hockey", "rugby League", "afl"}
+// Possible states for seeding meetings, keyed by country
+var (
+	meetingCountries = []string{"AUS", "NZL"}
+	meetingStates    = map[string][]string{
+		"AUS": {"NSW", "VIC", "QLD", "SA", "WA", "TAS"},
+		"NZL": {"AKL", "WGN", "CAN"},
+	}
+)
+
+// Possible track conditions and weather for seeding meetings
+var (
+	trackConditions = []string{"Firm 2", "Good 3", "Good 4", "Soft 5", "Soft 7", "Heavy 8", "Heavy 10"}
+	weatherData     = []string{"Fine", "Overcast", "Showers", "Rain"}
+)
+
+// SeedOptions controls the dummy data races are seeded with. The same options always make up the
+// same races, so an empty database, or any database seeded with Reset, ends up with the same data.
+type SeedOptions struct {
+	// Seed is where the random numbers the data is made up from start
+	Seed int64
+	// Races is how many races are seeded, spread across Meetings meetings
+	Races    int
+	Meetings int
+	// Now is the time races are seeded around, each starting between From and To after it. Races
+	// that have started by Now are seeded as run, some of them resulted.
+	Now      time.Time
+	From, To time.Duration
+	// Reset deletes everything stored before seeding, rather than only filling in what's missing
+	Reset bool
+}
+
+// DefaultSeedOptions returns the options for seeding 100 races across 10 meetings, starting from
+// a day ago up to two days from now.
+func DefaultSeedOptions() SeedOptions {
+	return SeedOptions{
+		Seed:     time.Now().UnixNano(),
+		Races:    100,
+		Meetings: 10,
+		Now:      time.Now().Truncate(time.Second),
+		From:     -24 * time.Hour,
+		To:       48 * time.Hour,
+	}
+}
+
+// Checks the options can seed races
+func (o SeedOptions) validate() error {
+	switch {
+	case o.Races < 0:
+		return fmt.Errorf("invalid seed options: %d races, it can't be negative", o.Races)
+	case o.Meetings < 1:
+		return fmt.Errorf("invalid seed options: %d meetings, there has to be at least one", o.Meetings)
+	case o.From >= o.To:
+		return fmt.Errorf("invalid seed options: races start from %s up to %s, the earliest has to come before the latest", o.From, o.To)
+	}
+
+	return nil
+}
+
+// The tables seeded, in the order they are reset
+var seedTables = []string{"races", "runners", "results", "dividends", "prices", "price_history", "meetings"}
 
 func (r *racesRepo) seed() error {
-	// Prepare SQL table if it doesn't exist
-	statement, err := r.db.Prepare(`CREATE TABLE IF NOT EXISTS races (id INTEGER PRIMARY KEY, meeting_id INTEGER, name TEXT, number INTEGER, visible INTEGER, advertised_start_time DATETIME)`)
-	if err == nil {
-		_, err = statement.Exec()
+	// Every random number the data is made up from follows on from the seed
+	r.random = rand.New(rand.NewSource(r.seeding.Seed))
+	faker.Seed(r.seeding.Seed)
+
+	// Start over from empty tables, which the same options always fill the same way
+	if r.seeding.Reset {
+		if err := r.resetSeed(); err != nil {
+			return err
+		}
 	}
 
+	statement, err := r.db.Prepare(`INSERT INTO races(id, meeting_id, name, number, visible, advertised_start_time, status) VALUES (?,?,?,?,?,?,?) ON CONFLICT DO NOTHING`)
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
 	// Populate with fake data
-	for i := 1; i <= 100; i++ {
-		statement, err = r.db.Prepare(`INSERT OR IGNORE INTO races(id, meeting_id, name, number, visible, advertised_start_time) VALUES (?,?,?,?,?,?)`)
-		if err == nil {
-			_, err = statement.Exec(
-				i,
-				faker.Number().Between(1, 10),
-				faker.Team().Name(),
-				faker.Number().Between(1, 12),
-				faker.Number().Between(0, 1),
-				faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2)).Format(time.RFC3339),
-			)
+	for i := 1; i <= r.seeding.Races; i++ {
+		advertisedStart := r.seedTime(r.seeding.From, r.seeding.To)
+
+		_, err = statement.Exec(
+			i,
+			1+r.random.Intn(r.seeding.Meetings),
+			faker.Team().Name(),
+			1+r.random.Intn(12),
+			r.random.Intn(2) == 1,
+			advertisedStart.Format(time.RFC3339),
+			r.seedRaceStatus(advertisedStart),
+		)
+		if err != nil {
+			return err
 		}
 	}
 
-	// Prepare sports SQL table if it doesn't exist
-	sportStatement, err := r.db.Prepare(`CREATE TABLE IF NOT EXISTS sports (id INTEGER PRIMARY KEY, name TEXT, advertised_start_time DATETIME, sport TEXT, current_score TEXT)`)
-	if err == nil {
-		_, err = sportStatement.Exec()
+	// Give every race a field of runners
+	for i := 1; i <= r.seeding.Races; i++ {
+		if err == nil {
+			err = r.seedRunners(i)
+		}
 	}
 
-	// Insert fake data into the table
-	for i := 1; i <= 100; i++ {
-		// Make a random team match up
-		teamA := faker.Team().Name()
-		teamB := faker.Team().Name()
-		name := fmt.Sprintf("%s VS %s", teamA, teamB)
+	// Result the races that have reached INTERIM or FINAL
+	for i := 1; i <= r.seeding.Races; i++ {
+		if err == nil {
+			err = r.seedResult(i)
+		}
+	}
 
-		// Select a random sport
-		sportIndex := rand.Intn(len(sportsData))
-		sport := sportsData[sportIndex]
+	// Price up every race
+	for i := 1; i <= r.seeding.Races; i++ {
+		if err == nil {
+			err = r.seedPrices(i)
+		}
+	}
 
-		// Make a random score
-		currentScore := fmt.Sprintf("%d-%d", rand.Intn(151), rand.Intn(151))
+	// Races are spread across the meetings
+	for i := 1; i <= r.seeding.Meetings; i++ {
+		if err == nil {
+			err = r.seedMeeting(i)
+		}
+	}
 
-		statement, err = r.db.Prepare(`INSERT OR IGNORE INTO sports(id, name, advertised_start_time, sport, current_score) VALUES (?,?,?,?,?)`)
+	// Races and meetings are seeded with their IDs, so new ones have to be given IDs after them
+	for _, table := range []string{"races", "meetings"} {
 		if err == nil {
-			_, err = statement.Exec(
-				i,
-				name,
-				faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2)).Format(time.RFC3339),
-				sport,
-				currentScore,
-			)
+			err = r.dialect.resetIDs(r.db, table)
+		}
+	}
+
+	return err
+}
+
+// Deletes every row seeded, and starts the IDs generated for each table over
+func (r *racesRepo) resetSeed() error {
+	for _, table := range seedTables {
+		if _, err := r.db.Exec(`DELETE FROM ` + table); err != nil {
+			return err
+		}
+	}
+	for _, table := range []string{"races", "runners", "dividends", "price_history", "meetings"} {
+		if err := r.dialect.resetIDs(r.db, table); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Picks a time between from and to after the time seeded around, to the second
+func (r *racesRepo) seedTime(from, to time.Duration) time.Time {
+	return r.seeding.Now.Add(from + time.Duration(r.random.Int63n(int64(to-from)))).Truncate(time.Second)
+}
+
+// Picks a stored status for a race, or nil to leave it to the advertised start time.
+// Most upcoming races follow the clock while most past races have been resulted.
+func (r *racesRepo) seedRaceStatus(advertisedStart time.Time) interface{} {
+	roll := r.random.Intn(10)
+
+	if advertisedStart.After(r.seeding.Now) {
+		switch roll {
+		case 0:
+			return racing.Race_SUSPENDED.String()
+		case 1:
+			return racing.Race_POSTPONED.String()
+		default:
+			return nil
 		}
 	}
 
+	switch {
+	case roll < 3:
+		return nil
+	case roll < 5:
+		return racing.Race_INTERIM.String()
+	case roll < 9:
+		return racing.Race_FINAL.String()
+	default:
+		return racing.Race_ABANDONED.String()
+	}
+}
+
+// Populates a meeting dated on the day of its first race
+func (r *racesRepo) seedMeeting(meetingID int) error {
+	var date string
+	err := r.db.QueryRow(
+		`SELECT COALESCE(`+r.dialect.date("MIN(advertised_start_time)")+`, ?) FROM races WHERE meeting_id = ?`,
+		r.seeding.Now.UTC().Format("2006-01-02"),
+		meetingID,
+	).Scan(&date)
+	if err != nil {
+		return err
+	}
+
+	country := meetingCountries[r.random.Intn(len(meetingCountries))]
+	states := meetingStates[country]
+	category := racing.Meeting_Category(1 + r.random.Intn(len(racing.Meeting_Category_name)-1))
+
+	_, err = r.db.Exec(
+		`INSERT INTO meetings(id, venue, state, country, category, date, track_condition, weather) VALUES (?,?,?,?,?,?,?,?) ON CONFLICT DO NOTHING`,
+		meetingID,
+		faker.Address().City(),
+		states[r.random.Intn(len(states))],
+		country,
+		category.String(),
+		date,
+		trackConditions[r.random.Intn(len(trackConditions))],
+		weatherData[r.random.Intn(len(weatherData))],
+	)
 	return err
 }
+
+// Populates a field of runners for a race that doesn't have one yet
+func (r *racesRepo) seedRunners(raceID int) error {
+	var count int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM runners WHERE race_id = ?`, raceID).Scan(&count); err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	// Fields are usually between 6 and 14 runners with barriers drawn at random
+	fieldSize := 6 + r.random.Intn(9)
+	barriers := r.random.Perm(fieldSize)
+
+	statement, err := r.db.Prepare(`INSERT INTO runners(race_id, saddle_number, name, barrier, jockey, trainer, weight, scratched) VALUES (?,?,?,?,?,?,?,?) ON CONFLICT DO NOTHING`)
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	for saddleNumber := 1; saddleNumber <= fieldSize; saddleNumber++ {
+		_, err = statement.Exec(
+			raceID,
+			saddleNumber,
+			runnerName(),
+			barriers[saddleNumber-1]+1,
+			personName(),
+			personName(),
+			// Weights range from 54kg to 60kg in half kilo steps
+			54+float64(r.random.Intn(13))/2,
+			// Roughly one in ten runners gets scratched
+			r.random.Intn(10) == 0,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// A runner as it finished in a seeded result
+type seedFinisher struct {
+	runnerID     int64
+	saddleNumber int64
+	position     int
+}
+
+// Populates finishing positions and dividends for a resulted race that doesn't have them yet
+func (r *racesRepo) seedResult(raceID int) error {
+	var resulted int
+	err := r.db.QueryRow(
+		`SELECT COUNT(*) FROM races WHERE id = ? AND status IN (?, ?) AND NOT EXISTS (SELECT 1 FROM results WHERE race_id = races.id)`,
+		raceID, racing.Race_INTERIM.String(), racing.Race_FINAL.String(),
+	).Scan(&resulted)
+	if err != nil || resulted == 0 {
+		return err
+	}
+
+	rows, err := r.db.Query(`SELECT id, saddle_number FROM runners WHERE race_id = ? AND NOT scratched`, raceID)
+	if err != nil {
+		return err
+	}
+	var finishers []*seedFinisher
+	for rows.Next() {
+		var finisher seedFinisher
+		if err := rows.Scan(&finisher.runnerID, &finisher.saddleNumber); err != nil {
+			rows.Close()
+			return err
+		}
+		finishers = append(finishers, &finisher)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// Shuffle the field into a finishing order, with the odd dead heat sharing a position
+	r.random.Shuffle(len(finishers), func(i, j int) { finishers[i], finishers[j] = finishers[j], finishers[i] })
+	var groups [][]*seedFinisher
+	for i, finisher := range finishers {
+		if i > 0 && r.random.Intn(20) == 0 {
+			finisher.position = finishers[i-1].position
+			groups[len(groups)-1] = append(groups[len(groups)-1], finisher)
+			continue
+		}
+		finisher.position = i + 1
+		groups = append(groups, []*seedFinisher{finisher})
+	}
+
+	for i, group := range groups {
+		// Margins are in lengths behind the runner in front. Dead heaters share theirs, as there
+		// is nothing between them.
+		margin := 0.0
+		if i > 0 {
+			margin = float64(1+r.random.Intn(40)) / 10
+		}
+
+		for _, finisher := range group {
+			_, err = r.db.Exec(`INSERT INTO results(race_id, runner_id, position, margin) VALUES (?,?,?,?) ON CONFLICT DO NOTHING`, raceID, finisher.runnerID, finisher.position, margin)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Small fields only pay two places
+	places := 3
+	if len(finishers) < 8 {
+		places = 2
+	}
+
+	dividends := []struct {
+		betType  racing.Dividend_BetType
+		picks    int
+		ordered  bool
+		min, max float64
+	}{
+		{racing.Dividend_WIN, 1, true, 1.5, 25},
+		{racing.Dividend_QUINELLA, 2, false, 5, 150},
+		{racing.Dividend_EXACTA, 2, true, 8, 300},
+		{racing.Dividend_TRIFECTA, 3, true, 20, 2000},
+		{racing.Dividend_FIRST_FOUR, 4, true, 50, 10000},
+	}
+	for _, dividend := range dividends {
+		combinations := seedCombinations(groups, dividend.picks, dividend.ordered)
+		amount := dividend.min + r.random.Float64()*(dividend.max-dividend.min)
+
+		// A dead heat splits the pool between each winning combination
+		for _, combination := range combinations {
+			if err := r.seedDividend(raceID, dividend.betType, combination, amount/float64(len(combinations))); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Every runner finishing within the places pays a place dividend, dead heats included
+	for _, finisher := range finishers {
+		if finisher.position <= places {
+			amount := 1.1 + r.random.Float64()*5
+			if err := r.seedDividend(raceID, racing.Dividend_PLACE, []int64{finisher.saddleNumber}, amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Inserts a dividend rounded to the nearest 10 cents
+func (r *racesRepo) seedDividend(raceID int, betType racing.Dividend_BetType, saddleNumbers []int64, amount float64) error {
+	numbers := make([]string, len(saddleNumbers))
+	for i, saddleNumber := range saddleNumbers {
+		numbers[i] = fmt.Sprint(saddleNumber)
+	}
+	if amount < 1.1 {
+		amount = 1.1
+	}
+
+	_, err := r.db.Exec(
+		`INSERT INTO dividends(race_id, bet_type, saddle_numbers, amount) VALUES (?,?,?,?)`,
+		raceID, betType.String(), strings.Join(numbers, ","), float64(int(amount*10+0.5))/10,
+	)
+	return err
+}
+
+// Works out every winning combination of saddle numbers for a bet on the first picks finishers.
+// Runners in the same group dead heated, so either can fill the positions the group covers.
+func seedCombinations(groups [][]*seedFinisher, picks int, ordered bool) [][]int64 {
+	// Work out which group covers each finishing slot
+	var slots [][]*seedFinisher
+	for _, group := range groups {
+		for range group {
+			slots = append(slots, group)
+		}
+	}
+	if len(slots) < picks {
+		return nil
+	}
+
+	var (
+		combinations [][]int64
+		seen         = make(map[string]bool)
+		pick         func(combination []int64, used map[int64]bool)
+	)
+	pick = func(combination []int64, used map[int64]bool) {
+		if len(combination) == picks {
+			key := append([]int64(nil), combination...)
+			// Unordered bets such as a quinella pay once per set of runners
+			if !ordered {
+				sort.Slice(key, func(i, j int) bool { return key[i] < key[j] })
+			}
+			if !seen[fmt.Sprint(key)] {
+				seen[fmt.Sprint(key)] = true
+				combinations = append(combinations, key)
+			}
+			return
+		}
+
+		for _, finisher := range slots[len(combination)] {
+			if used[finisher.runnerID] {
+				continue
+			}
+			used[finisher.runnerID] = true
+			pick(append(combination, finisher.saddleNumber), used)
+			used[finisher.runnerID] = false
+		}
+	}
+	pick(nil, make(map[int64]bool))
+
+	return combinations
+}
+
+// Populates prices for a race that hasn't been priced yet, along with how they fluctuated
+// in the day leading up to the race
+func (r *racesRepo) seedPrices(raceID int) error {
+	var (
+		advertisedStart time.Time
+		priced          int
+	)
+	err := r.db.QueryRow(`SELECT advertised_start_time, (SELECT COUNT(*) FROM prices WHERE race_id = races.id) FROM races WHERE id = ?`, raceID).Scan(&advertisedStart, &priced)
+	if err == sql.ErrNoRows || priced > 0 {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.db.Query(`SELECT id FROM runners WHERE race_id = ? AND NOT scratched`, raceID)
+	if err != nil {
+		return err
+	}
+	var runnerIDs []int64
+	for rows.Next() {
+		var runnerID int64
+		if err := rows.Scan(&runnerID); err != nil {
+			rows.Close()
+			return err
+		}
+		runnerIDs = append(runnerIDs, runnerID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// Give each runner a chance of winning, priced up with a 20% overround
+	chances := make([]float64, len(runnerIDs))
+	var total float64
+	for i := range chances {
+		chances[i] = 0.2 + r.random.Float64()
+		total += chances[i]
+	}
+
+	// Prices are recorded from the day before the race until it jumps, or now if that's sooner
+	opened := advertisedStart.Add(-24 * time.Hour)
+	closed := advertisedStart
+	if closed.After(r.seeding.Now) {
+		closed = r.seeding.Now
+	}
+	// Markets for races more than a day away opened early
+	if opened.After(closed) {
+		opened = closed.Add(-24 * time.Hour)
+	}
+	const fluctuations = 5
+
+	for i, runnerID := range runnerIDs {
+		win := total / (chances[i] * 1.2)
+
+		for step := 0; step < fluctuations; step++ {
+			// Drift up to 15% either way between each fluctuation
+			if step > 0 {
+				win *= 0.85 + r.random.Float64()*0.3
+			}
+			if win < 1.01 {
+				win = 1.01
+			}
+			place := 1 + (win-1)/3.5
+			updatedAt := opened.Add(closed.Sub(opened) * time.Duration(step) / (fluctuations - 1)).Format(time.RFC3339)
+
+			args := []interface{}{
+				raceID,
+				runnerID,
+				roundPrice(win),
+				roundPrice(place),
+				roundPrice(win * (0.85 + r.random.Float64()*0.3)),
+				roundPrice(place * (0.85 + r.random.Float64()*0.3)),
+				updatedAt,
+			}
+
+			_, err = r.db.Exec(`INSERT INTO price_history(race_id, runner_id, win_fixed, place_fixed, win_tote, place_tote, updated_at) VALUES (?,?,?,?,?,?,?)`, args...)
+			if err != nil {
+				return err
+			}
+
+			// The last fluctuation is the current price
+			if step == fluctuations-1 {
+				_, err = r.db.Exec(`INSERT INTO prices(race_id, runner_id, win_fixed, place_fixed, win_tote, place_tote, updated_at) VALUES (?,?,?,?,?,?,?) ON CONFLICT DO NOTHING`, args...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Rounds a price to the nearest cent
+func roundPrice(price float64) float64 {
+	return float64(int(price*100+0.5)) / 100
+}
+
+// Makes up a jockey or trainer name without titles or suffixes
+func personName() string {
+	return faker.Name().FirstName() + " " + faker.Name().LastName()
+}
+
+// Makes up a name for a horse or greyhound e.g. "Maroon Schaefer"
+func runnerName() string {
+	colour := faker.Commerce().Color()
+	return fmt.Sprintf("%s%s %s", strings.ToUpper(colour[:1]), colour[1:], faker.Name().LastName())
+}
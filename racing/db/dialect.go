@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+)
+
+// dialect covers the SQL that differs between the databases races can be stored in. Everything
+// else, including how races are filtered, ordered and paged through, is shared.
+type dialect interface {
+	// rebind rewrites the ? placeholders in a query into the form the driver takes
+	rebind(query string) string
+	// migrations names the directory of migrationFiles holding the schema for the dialect
+	migrations() string
+	// tableQuery counts the tables with the name given as its only argument, one or none
+	tableQuery() string
+	// epochSeconds is an expression for a stored time as seconds since the epoch
+	epochSeconds(column string) string
+	// date is an expression for the UTC date of a stored time, formatted as YYYY-MM-DD
+	date(column string) string
+	// resetIDs moves the IDs generated for a table past any that were inserted explicitly
+	resetIDs(db *database, table string) error
+
+	// supports reports whether the database can do what a migration requires, e.g. fts5
+	supports(db *database, feature string) (bool, error)
+	// searchable reports whether search can be used, once the schema is migrated
+	searchable(db *database) (bool, error)
+	// searchQuery finds the races, meetings and runners matching a searchMatch, one argument
+	// each, up to the count given as the last argument
+	searchQuery() string
+	// searchMatch turns the text typed into what searchQuery matches. Every word has to
+	// match, and the last can be the start of a word.
+	searchMatch(text string) string
+}
+
+// database wraps a connection, rewriting the placeholders in each query for the dialect in use
+type database struct {
+	*sql.DB
+	dialect dialect
+}
+
+func (d *database) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return d.DB.Query(d.dialect.rebind(query), args...)
+}
+
+func (d *database) QueryRow(query string, args ...interface{}) *sql.Row {
+	return d.DB.QueryRow(d.dialect.rebind(query), args...)
+}
+
+func (d *database) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return d.DB.Exec(d.dialect.rebind(query), args...)
+}
+
+func (d *database) Prepare(query string) (*sql.Stmt, error) {
+	return d.DB.Prepare(d.dialect.rebind(query))
+}
+
+func (d *database) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return d.DB.QueryContext(ctx, d.dialect.rebind(query), args...)
+}
+
+func (d *database) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return d.DB.QueryRowContext(ctx, d.dialect.rebind(query), args...)
+}
+
+func (d *database) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return d.DB.ExecContext(ctx, d.dialect.rebind(query), args...)
+}
+
+func (d *database) BeginTx(ctx context.Context, opts *sql.TxOptions) (*transaction, error) {
+	tx, err := d.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction{Tx: tx, dialect: d.dialect}, nil
+}
+
+// transaction wraps a transaction, rewriting placeholders like database does
+type transaction struct {
+	*sql.Tx
+	dialect dialect
+}
+
+func (t *transaction) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return t.Tx.ExecContext(ctx, t.dialect.rebind(query), args...)
+}
+
+func (t *transaction) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return t.Tx.QueryRowContext(ctx, t.dialect.rebind(query), args...)
+}
+
+// Numbers the ? placeholders in a query as $1, $2 and so on, leaving any in quotes alone
+func numberPlaceholders(query string) string {
+	var (
+		rebound strings.Builder
+		quoted  bool
+		n       int
+	)
+	for _, c := range query {
+		switch {
+		case c == '\'':
+			quoted = !quoted
+		case c == '?' && !quoted:
+			n++
+			rebound.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		rebound.WriteRune(c)
+	}
+
+	return rebound.String()
+}
@@ -0,0 +1,43 @@
+package db
+
+import "testing"
+
+func TestNumberPlaceholders(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"none", "SELECT 1", "SELECT 1"},
+		{"in order", "SELECT * FROM races WHERE id = ? AND version = ?", "SELECT * FROM races WHERE id = $1 AND version = $2"},
+		{"in a list", "races.meeting_id IN (?,?,?)", "races.meeting_id IN ($1,$2,$3)"},
+		{"in quotes", "SELECT '?' WHERE name = ? AND venue = 'who?'", "SELECT '?' WHERE name = $1 AND venue = 'who?'"},
+		{"after escaped quotes", "SELECT 'it''s ?' WHERE id = ?", "SELECT 'it''s ?' WHERE id = $1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := numberPlaceholders(test.query); got != test.want {
+				t.Errorf("numberPlaceholders(%q) = %q, want %q", test.query, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPostgresSearchMatch(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"", ""},
+		{"flem", "'flem':*"},
+		{"Flemington  cup", "'Flemington' & 'cup':*"},
+		{`o'brien's \ & !`, `'o''brien''s' & '\\' & '&' & '!':*`},
+	}
+
+	for _, test := range tests {
+		if got := (postgresDialect{}).searchMatch(test.text); got != test.want {
+			t.Errorf("searchMatch(%q) = %q, want %q", test.text, got, test.want)
+		}
+	}
+}
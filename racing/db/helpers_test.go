@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// Seeds a small, fixed set of races, so every run of the tests sees the same data
+func testSeedOptions() SeedOptions {
+	return SeedOptions{
+		Seed:     1,
+		Races:    20,
+		Meetings: 4,
+		Now:      time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		From:     -24 * time.Hour,
+		To:       48 * time.Hour,
+	}
+}
+
+// Opens a database in a SQLite file that is removed after the test, through the driver named
+func openSQLite(t *testing.T, driver string) *sql.DB {
+	t.Helper()
+
+	racingDB, err := sql.Open(driver, filepath.Join(t.TempDir(), "racing.db"))
+	if err != nil {
+		t.Fatalf("opening the database: %s", err)
+	}
+	t.Cleanup(func() { racingDB.Close() })
+
+	return racingDB
+}
+
+// Opens a migrated and seeded races repository stored in a SQLite file that is removed after the test
+func newSQLiteRacesRepo(t *testing.T) *racesRepo {
+	t.Helper()
+
+	return newTestRacesRepo(t, openSQLite(t, "sqlite3"))
+}
+
+// Opens a migrated and seeded races repository stored in the PostgreSQL database named by
+// RACING_TEST_POSTGRES_DSN, skipping the test when it isn't set outside of CI. Everything the races
+// schema holds in that database is dropped first.
+func newPostgresRacesRepo(t *testing.T) *racesRepo {
+	t.Helper()
+
+	dsn := os.Getenv("RACING_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		// CI always provides a database, so the tests can't quietly stop running against it there
+		if os.Getenv("CI") != "" {
+			t.Fatal("RACING_TEST_POSTGRES_DSN has to be set in CI")
+		}
+		t.Skip("RACING_TEST_POSTGRES_DSN isn't set")
+	}
+
+	racingDB, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("opening the database: %s", err)
+	}
+	t.Cleanup(func() { racingDB.Close() })
+
+	// Start over from an empty schema, whatever an earlier run left behind
+	if _, err := NewPostgresMigrator(racingDB).Down(context.Background(), 0); err != nil {
+		t.Fatalf("emptying the database: %s", err)
+	}
+
+	return migrateAndSeed(t, racingDB, postgresDialect{})
+}
+
+// Migrates and seeds a races repository stored in SQLite
+func newTestRacesRepo(t *testing.T, racingDB *sql.DB) *racesRepo {
+	t.Helper()
+
+	return migrateAndSeed(t, racingDB, sqliteDialect{})
+}
+
+// Migrates and seeds a races repository stored in the database given
+func migrateAndSeed(t *testing.T, racingDB *sql.DB, dialect dialect) *racesRepo {
+	t.Helper()
+
+	if _, err := newMigrator(racingDB, dialect).Up(context.Background(), 0); err != nil {
+		t.Fatalf("migrating the database: %s", err)
+	}
+
+	repo := newRacesRepo(racingDB, dialect)
+	if err := repo.Init(testSeedOptions()); err != nil {
+		t.Fatalf("seeding the database: %s", err)
+	}
+
+	return repo
+}
+
+// heldDriver is a SQLite driver whose strftime can hold up the query running it, which filtering
+// races by their advertised start time does for every race. It keeps a query running while its
+// context is cancelled or passes its deadline.
+const heldDriver = "sqlite3_held"
+
+func init() {
+	sql.Register(heldDriver, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("strftime", heldStrftime, false)
+		},
+	})
+}
+
+// queryHold holds up a query run through heldDriver until the test releases it
+type queryHold struct {
+	// started is closed once the query is running
+	started chan struct{}
+	release chan struct{}
+
+	start, stop sync.Once
+}
+
+var heldQuery atomic.Pointer[queryHold]
+
+// Holds up the next query run through heldDriver, letting it go by the end of the test at the latest
+func holdQuery(t *testing.T) *queryHold {
+	hold := &queryHold{started: make(chan struct{}), release: make(chan struct{})}
+	heldQuery.Store(hold)
+	t.Cleanup(func() {
+		heldQuery.Store(nil)
+		hold.Release()
+	})
+
+	return hold
+}
+
+// Release lets the query held up carry on
+func (h *queryHold) Release() {
+	h.stop.Do(func() { close(h.release) })
+}
+
+// Works out strftime('%s', value), the only way races use it, once any query hold is released
+func heldStrftime(format, value string) (int64, error) {
+	if hold := heldQuery.Load(); hold != nil {
+		hold.start.Do(func() { close(hold.started) })
+		<-hold.release
+	}
+
+	if format != "%s" {
+		return 0, fmt.Errorf("strftime format %q isn't supported", format)
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, err
+	}
+
+	return at.Unix(), nil
+}
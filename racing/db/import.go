@@ -0,0 +1,407 @@
+package db
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// A column of an imported file, and whether every row has to give it
+type importColumn struct {
+	name     string
+	required bool
+}
+
+// The columns of each kind of file imported. Races belong to meetings imported before them.
+var importColumns = map[racing.BulkImportRequest_Kind][]importColumn{
+	racing.BulkImportRequest_RACES: {
+		{"external_id", true},
+		{"meeting_external_id", true},
+		{"name", true},
+		{"number", true},
+		{"visible", false},
+		{"advertised_start_time", true},
+		{"status", false},
+	},
+	racing.BulkImportRequest_MEETINGS: {
+		{"external_id", true},
+		{"venue", true},
+		{"state", false},
+		{"country", true},
+		{"category", true},
+		{"date", true},
+		{"track_condition", false},
+		{"weather", false},
+	},
+}
+
+// A row of an imported file by column, along with any problems reading it
+type importRow struct {
+	line   int
+	values map[string]string
+	errors []*racing.ImportError
+}
+
+// Adds a problem with a column of the row
+func (r *importRow) fail(field, description string) {
+	r.errors = append(r.errors, &racing.ImportError{
+		Line:        int32(r.line),
+		ExternalId:  r.values["external_id"],
+		Field:       field,
+		Description: description,
+	})
+}
+
+// BulkImport creates or updates races or meetings by their external ID from the rows of a CSV or
+// NDJSON file. Rows with problems are left out and reported, and the rest are imported together,
+// or only checked on a dry run. Optional columns a row leaves out keep what is already stored.
+func (r *racesRepo) BulkImport(ctx context.Context, kind racing.BulkImportRequest_Kind, format racing.ImportFormat, data string, dryRun bool) (*racing.BulkImportResponse, error) {
+	columns, ok := importColumns[kind]
+	if !ok {
+		return nil, &InvalidFieldError{Field: "kind", Description: "must be RACES or MEETINGS"}
+	}
+	rows, err := readImportRows(format, data, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	response := &racing.BulkImportResponse{DryRun: dryRun}
+	for _, row := range rows {
+		var created bool
+		if len(row.errors) == 0 {
+			if kind == racing.BulkImportRequest_RACES {
+				created, err = r.importRace(ctx, tx, row)
+			} else {
+				created, err = r.importMeeting(ctx, tx, row)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch {
+		case len(row.errors) > 0:
+			response.Failed++
+			response.Errors = append(response.Errors, row.errors...)
+		case created:
+			response.Created++
+		default:
+			response.Updated++
+		}
+	}
+
+	// A dry run goes through every write so references between rows are checked, then undoes them
+	if dryRun {
+		return response, nil
+	}
+
+	return response, tx.Commit()
+}
+
+// Creates or updates a race from a row, reporting whether it was created. A race updated without
+// a status keeps the one it has, and a status given has to follow on from it as a transition would.
+func (r *racesRepo) importRace(ctx context.Context, tx *transaction, row *importRow) (bool, error) {
+	number, err := strconv.ParseInt(row.values["number"], 10, 64)
+	if err != nil || number < 1 {
+		row.fail("number", fmt.Sprintf("%q is not a number from 1", row.values["number"]))
+	}
+	var visible interface{}
+	if value := row.values["visible"]; value != "" {
+		if visible, err = strconv.ParseBool(value); err != nil {
+			row.fail("visible", fmt.Sprintf("%q is not true or false", value))
+		}
+	}
+	advertisedStart, err := time.Parse(time.RFC3339, row.values["advertised_start_time"])
+	if err != nil {
+		row.fail("advertised_start_time", fmt.Sprintf("%q is not an RFC 3339 time, e.g. 2024-01-01T12:00:00Z", row.values["advertised_start_time"]))
+	}
+	// Races without a status follow the clock, as seeded races do
+	var status interface{}
+	if value := strings.ToUpper(row.values["status"]); value != "" {
+		if racing.Race_Status_value[value] == 0 {
+			row.fail("status", fmt.Sprintf("%q is not a race status, e.g. OPEN or FINAL", row.values["status"]))
+		}
+		status = value
+	}
+
+	meetingID, err := importedID(ctx, tx, "meetings", row.values["meeting_external_id"])
+	if err == sql.ErrNoRows {
+		row.fail("meeting_external_id", fmt.Sprintf("no meeting has the external ID %q, import it first", row.values["meeting_external_id"]))
+	} else if err != nil {
+		return false, err
+	}
+	if len(row.errors) > 0 {
+		return false, nil
+	}
+
+	var (
+		id                int64
+		storedStatus      sql.NullString
+		storedStart       time.Time
+		advertisedStartAt = advertisedStart.Format(time.RFC3339)
+	)
+	err = tx.QueryRowContext(ctx, `SELECT id, status, advertised_start_time FROM races WHERE external_id = ?`, row.values["external_id"]).Scan(&id, &storedStatus, &storedStart)
+	if err == sql.ErrNoRows {
+		if visible == nil {
+			visible = false
+		}
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO races(meeting_id, name, number, visible, advertised_start_time, status, external_id, version) VALUES (?,?,?,?,?,?,?,1)`,
+			meetingID, row.values["name"], number, visible, advertisedStartAt, status, row.values["external_id"],
+		)
+		return true, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if status != nil {
+		from, to := raceStatus(storedStatus, storedStart), racing.Race_Status(racing.Race_Status_value[status.(string)])
+		if from != to && !canTransition(from, to) {
+			row.fail("status", fmt.Sprintf("the race is %s, which can't move to %s", from, to))
+			return false, nil
+		}
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE races SET meeting_id = ?, name = ?, number = ?, visible = COALESCE(?, visible), advertised_start_time = ?, status = COALESCE(?, status), version = version + 1 WHERE id = ?`,
+		meetingID, row.values["name"], number, visible, advertisedStartAt, status, id,
+	)
+	return false, err
+}
+
+// Creates or updates a meeting from a row, reporting whether it was created
+func (r *racesRepo) importMeeting(ctx context.Context, tx *transaction, row *importRow) (bool, error) {
+	category := strings.ToUpper(row.values["category"])
+	if racing.Meeting_Category_value[category] == 0 {
+		row.fail("category", fmt.Sprintf("%q is not THOROUGHBRED, HARNESS or GREYHOUND", row.values["category"]))
+	}
+	if _, err := time.Parse("2006-01-02", row.values["date"]); err != nil {
+		row.fail("date", fmt.Sprintf("%q is not a date, e.g. 2024-01-01", row.values["date"]))
+	}
+	if len(row.errors) > 0 {
+		return false, nil
+	}
+
+	state := strings.ToUpper(row.values["state"])
+	id, err := importedID(ctx, tx, "meetings", row.values["external_id"])
+	if err == sql.ErrNoRows {
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO meetings(venue, state, country, category, date, track_condition, weather, external_id) VALUES (?,?,?,?,?,?,?,?)`,
+			row.values["venue"],
+			state,
+			strings.ToUpper(row.values["country"]),
+			category,
+			row.values["date"],
+			row.values["track_condition"],
+			row.values["weather"],
+			row.values["external_id"],
+		)
+		return true, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE meetings SET venue = ?, state = COALESCE(?, state), country = ?, category = ?, date = ?, track_condition = COALESCE(?, track_condition), weather = COALESCE(?, weather) WHERE id = ?`,
+		row.values["venue"],
+		importedValue(state),
+		strings.ToUpper(row.values["country"]),
+		category,
+		row.values["date"],
+		importedValue(row.values["track_condition"]),
+		importedValue(row.values["weather"]),
+		id,
+	)
+	return false, err
+}
+
+// Turns an optional value a row leaves out into NULL, so an update keeps what is stored
+func importedValue(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
+
+// Looks up the ID of a row imported into a table by its external ID, or sql.ErrNoRows if there is none
+func importedID(ctx context.Context, tx *transaction, table, externalID string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, `SELECT id FROM `+table+` WHERE external_id = ?`, externalID).Scan(&id)
+	return id, err
+}
+
+// Reads the rows of a file, checking each has the columns given and nothing else. Problems with
+// the file as a whole are returned as an error, while problems with a row are left on the row.
+func readImportRows(format racing.ImportFormat, data string, columns []importColumn) ([]*importRow, error) {
+	known := make(map[string]bool)
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		known[column.name] = true
+		names[i] = column.name
+	}
+
+	var (
+		rows []*importRow
+		err  error
+	)
+	switch format {
+	case racing.ImportFormat_CSV:
+		rows, err = readImportCSV(data, known, names)
+	case racing.ImportFormat_NDJSON:
+		rows, err = readImportNDJSON(data, known, names)
+	default:
+		return nil, &InvalidFieldError{Field: "format", Description: "must be CSV or NDJSON"}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, &InvalidFieldError{Field: "data", Description: "has no rows to import"}
+	}
+
+	for _, row := range rows {
+		// Rows that couldn't be read at all only report that
+		if len(row.errors) > 0 && row.errors[0].Field == "" {
+			continue
+		}
+		for _, column := range columns {
+			if column.required && row.values[column.name] == "" {
+				row.fail(column.name, "is required")
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+// Reads a CSV file with a header naming the columns
+func readImportCSV(data string, known map[string]bool, names []string) ([]*importRow, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	// Rows with the wrong number of values are reported rather than stopping the import
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, &InvalidFieldError{Field: "data", Description: "is empty, it needs a header naming the columns"}
+	}
+	if err != nil {
+		return nil, &InvalidFieldError{Field: "data", Description: err.Error()}
+	}
+	seen := make(map[string]bool)
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !known[name] {
+			return nil, &InvalidFieldError{Field: "data", Description: fmt.Sprintf("line 1: %q is not a column, use %s", name, strings.Join(names, ", "))}
+		}
+		if seen[name] {
+			return nil, &InvalidFieldError{Field: "data", Description: fmt.Sprintf("line 1: %q is in the header twice", name)}
+		}
+		seen[name] = true
+		header[i] = name
+	}
+
+	var rows []*importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &InvalidFieldError{Field: "data", Description: err.Error()}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &importRow{line: line, values: make(map[string]string)}
+		for i, value := range record {
+			if i < len(header) {
+				row.values[header[i]] = strings.TrimSpace(value)
+			}
+		}
+		if len(record) != len(header) {
+			row.fail("", fmt.Sprintf("has %d values, the header names %d columns", len(record), len(header)))
+		}
+		rows = append(rows, row)
+	}
+}
+
+// Reads an NDJSON file, a JSON object on each line keyed by column. Blank lines are skipped.
+func readImportNDJSON(data string, known map[string]bool, names []string) ([]*importRow, error) {
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), len(data)+1)
+
+	var rows []*importRow
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		row := &importRow{line: line, values: make(map[string]string)}
+		rows = append(rows, row)
+
+		var object map[string]interface{}
+		decoder := json.NewDecoder(strings.NewReader(text))
+		decoder.UseNumber()
+		if err := decoder.Decode(&object); err != nil || object == nil {
+			row.fail("", "is not a JSON object")
+			continue
+		}
+
+		// Read the external ID first so every problem with the row can name it
+		if id, ok := object["external_id"].(string); ok {
+			row.values["external_id"] = strings.TrimSpace(id)
+		}
+		keys := make([]string, 0, len(object))
+		for name := range object {
+			keys = append(keys, name)
+		}
+		sort.Strings(keys)
+		for _, name := range keys {
+			if !known[name] {
+				row.fail(name, fmt.Sprintf("is not a column, use %s", strings.Join(names, ", ")))
+				continue
+			}
+			switch value := object[name].(type) {
+			case nil:
+			case string:
+				row.values[name] = strings.TrimSpace(value)
+			case json.Number:
+				row.values[name] = value.String()
+			case bool:
+				row.values[name] = strconv.FormatBool(value)
+			default:
+				row.fail(name, "must be a string, number or boolean")
+			}
+		}
+	}
+
+	return rows, scanner.Err()
+}
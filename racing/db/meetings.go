@@ -0,0 +1,131 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// Compiles the list of meetings and applies filters if present
+func (r *racesRepo) ListMeetings(ctx context.Context, filter *racing.ListMeetingsRequestFilter) ([]*racing.Meeting, error) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	query := getMeetingQueries()[meetingsList]
+
+	if filter != nil {
+		// Filters via meeting ID - int array
+		if len(filter.Ids) > 0 {
+			clauses = append(clauses, "meetings.id IN ("+strings.Repeat("?,", len(filter.Ids)-1)+"?)")
+
+			for _, id := range filter.Ids {
+				args = append(args, id)
+			}
+		}
+
+		meetingClauses, meetingArgs := meetingFilterClauses(filter.Categories, filter.Date, filter.Countries)
+		clauses = append(clauses, meetingClauses...)
+		args = append(args, meetingArgs...)
+	}
+
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+	query += " ORDER BY date, venue"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanMeetings(rows)
+}
+
+// Get a meeting by its Id
+func (r *racesRepo) GetMeeting(ctx context.Context, id int64) (*racing.Meeting, error) {
+	rows, err := r.db.QueryContext(ctx, getMeetingQueries()[meetingsList]+" WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	meetings, err := r.scanMeetings(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(meetings) == 0 {
+		return nil, notFound("meeting", id)
+	}
+
+	return meetings[0], nil
+}
+
+// Builds the WHERE clauses shared by meeting and race filters on meeting attributes
+func meetingFilterClauses(categories []racing.Meeting_Category, date string, countries []string) ([]string, []interface{}) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	// Filters via category - stored as the enum name e.g. GREYHOUND
+	if len(categories) > 0 {
+		clauses = append(clauses, "meetings.category IN ("+strings.Repeat("?,", len(categories)-1)+"?)")
+
+		for _, category := range categories {
+			args = append(args, category.String())
+		}
+	}
+	// Filters via the date of the meeting
+	if date != "" {
+		clauses = append(clauses, "meetings.date = ?")
+		args = append(args, date)
+	}
+	// Filters via country code, ignoring case
+	if len(countries) > 0 {
+		clauses = append(clauses, "meetings.country IN ("+strings.Repeat("?,", len(countries)-1)+"?)")
+
+		for _, country := range countries {
+			args = append(args, strings.ToUpper(country))
+		}
+	}
+
+	return clauses, args
+}
+
+// Scans the SQL database and returns meetings
+func (r *racesRepo) scanMeetings(
+	rows *sql.Rows,
+) ([]*racing.Meeting, error) {
+	var meetings []*racing.Meeting
+
+	for rows.Next() {
+		var (
+			meeting  racing.Meeting
+			category string
+		)
+
+		if err := rows.Scan(
+			&meeting.Id,
+			&meeting.Venue,
+			&meeting.State,
+			&meeting.Country,
+			&category,
+			&meeting.Date,
+			&meeting.TrackCondition,
+			&meeting.Weather,
+		); err != nil {
+			return nil, err
+		}
+
+		meeting.Category = racing.Meeting_Category(racing.Meeting_Category_value[category])
+
+		meetings = append(meetings, &meeting)
+	}
+
+	return meetings, rows.Err()
+}
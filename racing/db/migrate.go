@@ -0,0 +1,301 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The schema of the races database, one version at a time. Each version is a pair of files under
+// the directory for a dialect, NNNN_name.up.sql making the change and NNNN_name.down.sql undoing it.
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+// Migrator provides access to the schema of the races database.
+type Migrator interface {
+	// Up will apply the migrations after the current version up to and including the version
+	// given, returning the ones applied. A version of zero applies every migration.
+	Up(ctx context.Context, version int) ([]Migration, error)
+	// Down will undo the migrations after the version given, newest first, returning the ones undone
+	Down(ctx context.Context, version int) ([]Migration, error)
+	// Status will return every migration, marking the ones applied
+	Status(ctx context.Context) ([]Migration, error)
+}
+
+// Migration is a single version of the schema
+type Migration struct {
+	Version int
+	Name    string
+	Applied bool
+	// Requires is what the database has to support for the migration, e.g. fts5, if anything.
+	// It is given by a "-- requires: " line at the top of the migration.
+	Requires string
+	// Unsupported is set when the database doesn't support what the migration requires. Up leaves
+	// it pending and carries on with the migrations after it.
+	Unsupported bool
+
+	up   string
+	down string
+}
+
+type migrator struct {
+	db      *database
+	dialect dialect
+}
+
+// NewMigrator creates a new migrator for races stored in SQLite.
+func NewMigrator(db *sql.DB) Migrator {
+	return newMigrator(db, sqliteDialect{})
+}
+
+// NewPostgresMigrator creates a new migrator for races stored in PostgreSQL.
+func NewPostgresMigrator(db *sql.DB) Migrator {
+	return newMigrator(db, postgresDialect{})
+}
+
+func newMigrator(db *sql.DB, dialect dialect) *migrator {
+	return &migrator{db: &database{DB: db, dialect: dialect}, dialect: dialect}
+}
+
+// Applies migrations in order, each in its own transaction along with recording its version
+func (m *migrator) Up(ctx context.Context, version int) ([]Migration, error) {
+	migrations, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(migrations, version); err != nil {
+		return nil, err
+	}
+
+	if err := checkSupported(migrations, version); err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range migrations {
+		if migration.Applied || migration.Unsupported || (version != 0 && migration.Version > version) {
+			continue
+		}
+
+		err := m.apply(ctx, migration.up, `INSERT INTO schema_version(version, name) VALUES (?,?)`, migration.Version, migration.Name)
+		if err != nil {
+			return applied, fmt.Errorf("migrating up to %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		migration.Applied = true
+		applied = append(applied, migration)
+	}
+
+	return applied, nil
+}
+
+// Undoes migrations newest first, each in its own transaction along with forgetting its version
+func (m *migrator) Down(ctx context.Context, version int) ([]Migration, error) {
+	migrations, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(migrations, version); err != nil {
+		return nil, err
+	}
+
+	var undone []Migration
+	for i := len(migrations) - 1; i >= 0; i-- {
+		migration := migrations[i]
+		if !migration.Applied || migration.Version <= version {
+			continue
+		}
+		if migration.Unsupported {
+			return undone, fmt.Errorf("migration %04d_%s can't be undone, the database doesn't support %s", migration.Version, migration.Name, migration.Requires)
+		}
+
+		err := m.apply(ctx, migration.down, `DELETE FROM schema_version WHERE version = ?`, migration.Version)
+		if err != nil {
+			return undone, fmt.Errorf("migrating down from %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		migration.Applied = false
+		undone = append(undone, migration)
+	}
+
+	return undone, nil
+}
+
+// Lists the migrations for the dialect in order, marking the ones recorded in schema_version
+func (m *migrator) Status(ctx context.Context) ([]Migration, error) {
+	migrations, err := readMigrations(m.dialect.migrations())
+	if err != nil {
+		return nil, err
+	}
+	if err := m.createVersionTable(ctx); err != nil {
+		return nil, err
+	}
+
+	rows, err := m.db.QueryContext(ctx, `SELECT version FROM schema_version`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]bool)
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	supported := make(map[string]bool)
+	for i := range migrations {
+		migrations[i].Applied = applied[migrations[i].Version]
+
+		requires := migrations[i].Requires
+		if requires == "" {
+			continue
+		}
+		if _, checked := supported[requires]; !checked {
+			if supported[requires], err = m.dialect.supports(m.db, requires); err != nil {
+				return nil, err
+			}
+		}
+		migrations[i].Unsupported = !supported[requires]
+	}
+
+	return migrations, nil
+}
+
+// Runs the statements of a migration and the statement recording it together
+func (m *migrator) apply(ctx context.Context, statements, record string, args ...interface{}) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, statements); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Creates schema_version if it doesn't exist. Databases seeded before there were migrations
+// already have the races table the first migration creates, so they start at version 1.
+func (m *migrator) createVersionTable(ctx context.Context) error {
+	var exists int
+	if err := m.db.QueryRowContext(ctx, m.dialect.tableQuery(), "schema_version").Scan(&exists); err != nil || exists > 0 {
+		return err
+	}
+
+	var legacy int
+	if err := m.db.QueryRowContext(ctx, m.dialect.tableQuery(), "races").Scan(&legacy); err != nil {
+		return err
+	}
+
+	_, err := m.db.ExecContext(ctx, `CREATE TABLE schema_version (version INTEGER PRIMARY KEY, name TEXT NOT NULL, applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP)`)
+	if err == nil && legacy > 0 {
+		_, err = m.db.ExecContext(ctx, `INSERT INTO schema_version(version, name) VALUES (1, 'create_races')`)
+	}
+
+	return err
+}
+
+// Reads the migrations in a directory, checking every version has both an up and a down
+func readMigrations(dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		name := entry.Name()
+		base := strings.TrimSuffix(name, ".sql")
+		direction := path.Ext(base)
+		number, label, found := strings.Cut(strings.TrimSuffix(base, direction), "_")
+		version, err := strconv.Atoi(number)
+		if !found || err != nil || version <= 0 || (direction != ".up" && direction != ".down") {
+			return nil, fmt.Errorf("migration %s is not named NNNN_name.up.sql or NNNN_name.down.sql", name)
+		}
+
+		contents, err := fs.ReadFile(migrationFiles, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: label}
+			byVersion[version] = migration
+		}
+		if migration.Name != label {
+			return nil, fmt.Errorf("migration %04d is named both %s and %s", version, migration.Name, label)
+		}
+		if direction == ".up" {
+			migration.up = string(contents)
+			if line, _, _ := strings.Cut(migration.up, "\n"); strings.HasPrefix(line, "-- requires: ") {
+				migration.Requires = strings.TrimSpace(strings.TrimPrefix(line, "-- requires: "))
+			}
+		} else {
+			migration.down = string(contents)
+		}
+	}
+
+	var migrations []Migration
+	for _, migration := range byVersion {
+		if migration.up == "" || migration.down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// Checks a version to migrate to is zero or one of the migrations
+func checkVersion(migrations []Migration, version int) error {
+	if version == 0 {
+		return nil
+	}
+	for _, migration := range migrations {
+		if migration.Version == version {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("there is no migration %04d", version)
+}
+
+// Checks every applied migration is supported by the database, as a database the schema can't be
+// kept up to date on, e.g. SQLite without FTS5 and its triggers, can't be written to either. Migrating
+// up to a version that isn't supported fails too, rather than leaving it pending.
+func checkSupported(migrations []Migration, version int) error {
+	for _, migration := range migrations {
+		if !migration.Unsupported {
+			continue
+		}
+		if migration.Applied {
+			return fmt.Errorf("migration %04d_%s is applied, but the database doesn't support %s, which it requires", migration.Version, migration.Name, migration.Requires)
+		}
+		if migration.Version == version {
+			return fmt.Errorf("migration %04d_%s can't be applied, the database doesn't support %s", migration.Version, migration.Name, migration.Requires)
+		}
+	}
+
+	return nil
+}
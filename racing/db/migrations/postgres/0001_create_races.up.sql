@@ -0,0 +1,2 @@
+-- Names sort byte by byte as they do in SQLite, rather than by the collation of the database
+CREATE TABLE races (id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, meeting_id BIGINT, name TEXT COLLATE "C", number BIGINT, visible BOOLEAN, advertised_start_time TIMESTAMPTZ);
@@ -0,0 +1 @@
+CREATE TABLE runners (id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, race_id BIGINT, saddle_number BIGINT, name TEXT COLLATE "C", barrier BIGINT, jockey TEXT, trainer TEXT, weight DOUBLE PRECISION, scratched BOOLEAN, UNIQUE(race_id, saddle_number));
@@ -0,0 +1 @@
+CREATE TABLE meetings (id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, venue TEXT COLLATE "C", state TEXT, country TEXT, category TEXT, date TEXT, track_condition TEXT, weather TEXT);
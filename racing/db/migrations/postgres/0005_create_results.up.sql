@@ -0,0 +1,2 @@
+CREATE TABLE results (race_id BIGINT, runner_id BIGINT, position BIGINT, margin DOUBLE PRECISION, PRIMARY KEY (race_id, runner_id));
+CREATE TABLE dividends (id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, race_id BIGINT, bet_type TEXT, saddle_numbers TEXT, amount DOUBLE PRECISION);
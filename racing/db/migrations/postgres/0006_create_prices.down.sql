@@ -0,0 +1,2 @@
+DROP TABLE price_history;
+DROP TABLE prices;
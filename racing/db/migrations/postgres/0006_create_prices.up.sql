@@ -0,0 +1,2 @@
+CREATE TABLE prices (race_id BIGINT, runner_id BIGINT, win_fixed DOUBLE PRECISION, place_fixed DOUBLE PRECISION, win_tote DOUBLE PRECISION, place_tote DOUBLE PRECISION, updated_at TIMESTAMPTZ, PRIMARY KEY (race_id, runner_id));
+CREATE TABLE price_history (id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, race_id BIGINT, runner_id BIGINT, win_fixed DOUBLE PRECISION, place_fixed DOUBLE PRECISION, win_tote DOUBLE PRECISION, place_tote DOUBLE PRECISION, updated_at TIMESTAMPTZ);
@@ -0,0 +1,5 @@
+-- Races and meetings imported from upstream providers are matched up by the provider's ID
+ALTER TABLE races ADD COLUMN external_id TEXT;
+CREATE UNIQUE INDEX races_external_id ON races (external_id);
+ALTER TABLE meetings ADD COLUMN external_id TEXT;
+CREATE UNIQUE INDEX meetings_external_id ON meetings (external_id);
@@ -0,0 +1,3 @@
+DROP INDEX races_search;
+DROP INDEX meetings_search;
+DROP INDEX runners_search;
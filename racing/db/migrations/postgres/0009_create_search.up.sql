@@ -0,0 +1,4 @@
+-- Indexes over the names searched. Databases searched before there were migrations already have them.
+CREATE INDEX IF NOT EXISTS races_search ON races USING GIN (to_tsvector('simple', name));
+CREATE INDEX IF NOT EXISTS meetings_search ON meetings USING GIN (to_tsvector('simple', venue));
+CREATE INDEX IF NOT EXISTS runners_search ON runners USING GIN (to_tsvector('simple', name));
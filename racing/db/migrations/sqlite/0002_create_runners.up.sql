@@ -0,0 +1 @@
+CREATE TABLE runners (id INTEGER PRIMARY KEY, race_id INTEGER, saddle_number INTEGER, name TEXT, barrier INTEGER, jockey TEXT, trainer TEXT, weight REAL, scratched INTEGER, UNIQUE(race_id, saddle_number));
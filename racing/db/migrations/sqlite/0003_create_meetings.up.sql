@@ -0,0 +1 @@
+CREATE TABLE meetings (id INTEGER PRIMARY KEY, venue TEXT, state TEXT, country TEXT, category TEXT, date TEXT, track_condition TEXT, weather TEXT);
@@ -0,0 +1 @@
+ALTER TABLE races ADD COLUMN status TEXT;
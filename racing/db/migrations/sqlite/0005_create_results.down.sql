@@ -0,0 +1,2 @@
+DROP TABLE dividends;
+DROP TABLE results;
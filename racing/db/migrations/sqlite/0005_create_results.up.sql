@@ -0,0 +1,2 @@
+CREATE TABLE results (race_id INTEGER, runner_id INTEGER, position INTEGER, margin REAL, PRIMARY KEY (race_id, runner_id));
+CREATE TABLE dividends (id INTEGER PRIMARY KEY, race_id INTEGER, bet_type TEXT, saddle_numbers TEXT, amount REAL);
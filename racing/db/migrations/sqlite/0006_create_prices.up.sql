@@ -0,0 +1,2 @@
+CREATE TABLE prices (race_id INTEGER, runner_id INTEGER, win_fixed REAL, place_fixed REAL, win_tote REAL, place_tote REAL, updated_at DATETIME, PRIMARY KEY (race_id, runner_id));
+CREATE TABLE price_history (id INTEGER PRIMARY KEY, race_id INTEGER, runner_id INTEGER, win_fixed REAL, place_fixed REAL, win_tote REAL, place_tote REAL, updated_at DATETIME);
@@ -0,0 +1,4 @@
+DROP INDEX meetings_external_id;
+ALTER TABLE meetings DROP COLUMN external_id;
+DROP INDEX races_external_id;
+ALTER TABLE races DROP COLUMN external_id;
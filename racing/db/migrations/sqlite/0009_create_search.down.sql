@@ -0,0 +1,13 @@
+-- requires: fts5
+DROP TRIGGER races_search_insert;
+DROP TRIGGER races_search_delete;
+DROP TRIGGER races_search_update;
+DROP TABLE races_search;
+DROP TRIGGER meetings_search_insert;
+DROP TRIGGER meetings_search_delete;
+DROP TRIGGER meetings_search_update;
+DROP TABLE meetings_search;
+DROP TRIGGER runners_search_insert;
+DROP TRIGGER runners_search_delete;
+DROP TRIGGER runners_search_update;
+DROP TABLE runners_search;
@@ -0,0 +1,39 @@
+-- requires: fts5
+-- Full-text indexes over the names searched, kept up to date by triggers on the tables they index.
+-- Databases searched before there were migrations already have them, and are only reindexed.
+CREATE VIRTUAL TABLE IF NOT EXISTS races_search USING fts5(name, content='races', content_rowid='id');
+CREATE TRIGGER IF NOT EXISTS races_search_insert AFTER INSERT ON races BEGIN
+	INSERT INTO races_search(rowid, name) VALUES (new.id, new.name);
+END;
+CREATE TRIGGER IF NOT EXISTS races_search_delete AFTER DELETE ON races BEGIN
+	INSERT INTO races_search(races_search, rowid, name) VALUES ('delete', old.id, old.name);
+END;
+CREATE TRIGGER IF NOT EXISTS races_search_update AFTER UPDATE OF name ON races BEGIN
+	INSERT INTO races_search(races_search, rowid, name) VALUES ('delete', old.id, old.name);
+	INSERT INTO races_search(rowid, name) VALUES (new.id, new.name);
+END;
+INSERT INTO races_search(races_search) VALUES ('rebuild');
+CREATE VIRTUAL TABLE IF NOT EXISTS meetings_search USING fts5(venue, content='meetings', content_rowid='id');
+CREATE TRIGGER IF NOT EXISTS meetings_search_insert AFTER INSERT ON meetings BEGIN
+	INSERT INTO meetings_search(rowid, venue) VALUES (new.id, new.venue);
+END;
+CREATE TRIGGER IF NOT EXISTS meetings_search_delete AFTER DELETE ON meetings BEGIN
+	INSERT INTO meetings_search(meetings_search, rowid, venue) VALUES ('delete', old.id, old.venue);
+END;
+CREATE TRIGGER IF NOT EXISTS meetings_search_update AFTER UPDATE OF venue ON meetings BEGIN
+	INSERT INTO meetings_search(meetings_search, rowid, venue) VALUES ('delete', old.id, old.venue);
+	INSERT INTO meetings_search(rowid, venue) VALUES (new.id, new.venue);
+END;
+INSERT INTO meetings_search(meetings_search) VALUES ('rebuild');
+CREATE VIRTUAL TABLE IF NOT EXISTS runners_search USING fts5(name, content='runners', content_rowid='id');
+CREATE TRIGGER IF NOT EXISTS runners_search_insert AFTER INSERT ON runners BEGIN
+	INSERT INTO runners_search(rowid, name) VALUES (new.id, new.name);
+END;
+CREATE TRIGGER IF NOT EXISTS runners_search_delete AFTER DELETE ON runners BEGIN
+	INSERT INTO runners_search(runners_search, rowid, name) VALUES ('delete', old.id, old.name);
+END;
+CREATE TRIGGER IF NOT EXISTS runners_search_update AFTER UPDATE OF name ON runners BEGIN
+	INSERT INTO runners_search(runners_search, rowid, name) VALUES ('delete', old.id, old.name);
+	INSERT INTO runners_search(rowid, name) VALUES (new.id, new.name);
+END;
+INSERT INTO runners_search(runners_search) VALUES ('rebuild');
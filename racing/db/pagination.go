@@ -0,0 +1,238 @@
+package db
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidPageToken is returned when a page token can't be decoded or was issued for a different ordering.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// A field that results are ordered by, along with the SQL expression used to sort and compare it
+type sortKey struct {
+	field  string
+	column string
+	desc   bool
+}
+
+// The decoded form of the opaque token handed out for the next page. It holds the sort key
+// values of the last row on the page so the next page starts straight after it, even if rows
+// have been added or removed in the meantime.
+type pageToken struct {
+	Order  string        `json:"o"`
+	Values []interface{} `json:"v"`
+}
+
+// A field to order by as given in a request, with its direction of asc or desc
+type orderField struct {
+	field string
+	sort  string
+}
+
+// Works out the fields to order by. Either a list of fields, each with its own direction, or a
+// single orderBy and sort can be given, defaulting to advertised_start_time when neither is. The
+// primary key is always added last so rows that tie keep a stable order.
+func orderKeys(dialect dialect, table string, validFields []string, orderBy, sort string, fields []orderField) ([]sortKey, error) {
+	if len(fields) == 0 {
+		return singleOrderKeys(dialect, table, validFields, orderBy, sort)
+	}
+	if orderBy != "" || sort != "" {
+		return nil, &InvalidFieldError{
+			Field:       "filter.sortKeys",
+			Description: "can't be given along with orderBy or sort",
+		}
+	}
+
+	var keys []sortKey
+	ordered := make(map[string]bool)
+	for i, field := range fields {
+		name := fmt.Sprintf("filter.sortKeys[%d]", i)
+
+		key, err := validSortKey(dialect, table, validFields, name+".field", field.field)
+		if err != nil {
+			return nil, err
+		}
+		if ordered[key.field] {
+			return nil, &InvalidFieldError{
+				Field:       name + ".field",
+				Description: fmt.Sprintf("%q is already ordered by", key.field),
+			}
+		}
+		ordered[key.field] = true
+
+		if key.desc, err = isDescending(name+".sort", field.sort); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	if !ordered["id"] {
+		keys = append(keys, newSortKey(dialect, table, "id"))
+	}
+
+	return keys, nil
+}
+
+// Works out the fields to order by from a single orderBy and sort
+func singleOrderKeys(dialect dialect, table string, validFields []string, orderBy, sort string) ([]sortKey, error) {
+	key := newSortKey(dialect, table, "advertised_start_time")
+
+	if orderBy != "" {
+		var err error
+		if key, err = validSortKey(dialect, table, validFields, "filter.orderBy", orderBy); err != nil {
+			return nil, err
+		}
+	}
+
+	desc, err := isDescending("filter.sort", sort)
+	if err != nil {
+		return nil, err
+	}
+	key.desc = desc && orderBy != ""
+
+	keys := []sortKey{key}
+	if key.field != "id" {
+		keys = append(keys, newSortKey(dialect, table, "id"))
+	}
+
+	return keys, nil
+}
+
+// Creates a sort key for a field named in the request, checking it's one results can be ordered by
+func validSortKey(dialect dialect, table string, validFields []string, name, field string) (sortKey, error) {
+	for _, valid := range validFields {
+		if field == valid {
+			return newSortKey(dialect, table, valid), nil
+		}
+	}
+
+	return sortKey{}, &InvalidFieldError{
+		Field:       name,
+		Description: fmt.Sprintf("%q is not one of %s", field, strings.Join(validFields, ", ")),
+	}
+}
+
+// Reports whether a sort direction, asc or desc in any case, is descending. Empty means asc.
+func isDescending(name, sort string) (bool, error) {
+	switch strings.ToLower(sort) {
+	case "", "asc":
+		return false, nil
+	case "desc":
+		return true, nil
+	default:
+		return false, &InvalidFieldError{
+			Field:       name,
+			Description: fmt.Sprintf("%q is not asc or desc", sort),
+		}
+	}
+}
+
+// Creates an ascending sort key for a field, sorting start times as seconds since the epoch
+func newSortKey(dialect dialect, table, field string) sortKey {
+	column := table + "." + field
+	if field == "advertised_start_time" {
+		column = dialect.epochSeconds(column)
+	}
+
+	return sortKey{field: field, column: column}
+}
+
+// Builds the ORDER BY clause for the sort keys
+func orderClause(keys []sortKey) string {
+	columns := make([]string, len(keys))
+	for i, key := range keys {
+		columns[i] = key.column + " ASC"
+		if key.desc {
+			columns[i] = key.column + " DESC"
+		}
+	}
+
+	return " ORDER BY " + strings.Join(columns, ", ")
+}
+
+// Describes the ordering so a token can't be replayed against a different one
+func orderFingerprint(keys []sortKey) string {
+	fields := make([]string, len(keys))
+	for i, key := range keys {
+		fields[i] = key.field
+		if key.desc {
+			fields[i] += " desc"
+		}
+	}
+
+	return strings.Join(fields, ",")
+}
+
+// Builds a WHERE clause matching the rows that sort after the values in the token, e.g. for
+// name then id: (name > ?) OR (name = ? AND id > ?)
+func afterClause(keys []sortKey, values []interface{}) (string, []interface{}) {
+	var (
+		alternatives []string
+		args         []interface{}
+	)
+
+	for i, key := range keys {
+		var conditions []string
+		for j := 0; j < i; j++ {
+			conditions = append(conditions, keys[j].column+" = ?")
+			args = append(args, values[j])
+		}
+
+		operator := " > ?"
+		if key.desc {
+			operator = " < ?"
+		}
+		conditions = append(conditions, key.column+operator)
+		args = append(args, values[i])
+
+		alternatives = append(alternatives, "("+strings.Join(conditions, " AND ")+")")
+	}
+
+	return "(" + strings.Join(alternatives, " OR ") + ")", args
+}
+
+// Encodes the sort key values of the last row on a page into an opaque token
+func encodePageToken(keys []sortKey, values []interface{}) (string, error) {
+	token, err := json.Marshal(pageToken{Order: orderFingerprint(keys), Values: values})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Decodes a page token, checking it was issued for the same ordering
+func decodePageToken(keys []sortKey, encoded string) (*pageToken, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var token pageToken
+	decoder := json.NewDecoder(strings.NewReader(string(raw)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&token); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if token.Order != orderFingerprint(keys) || len(token.Values) != len(keys) {
+		return nil, ErrInvalidPageToken
+	}
+
+	// Numbers come back as json.Number, which the driver can't bind
+	for i, value := range token.Values {
+		if number, ok := value.(json.Number); ok {
+			if integer, err := number.Int64(); err == nil {
+				token.Values[i] = integer
+			} else if float, err := number.Float64(); err == nil {
+				token.Values[i] = float
+			} else {
+				return nil, ErrInvalidPageToken
+			}
+		}
+	}
+
+	return &token, nil
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	_ "github.com/lib/pq"
+)
+
+// postgresDialect stores races in PostgreSQL
+type postgresDialect struct{}
+
+func (postgresDialect) rebind(query string) string {
+	return numberPlaceholders(query)
+}
+
+func (postgresDialect) migrations() string {
+	return "migrations/postgres"
+}
+
+func (postgresDialect) tableQuery() string {
+	return `SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = ?`
+}
+
+func (postgresDialect) epochSeconds(column string) string {
+	return "CAST(EXTRACT(EPOCH FROM " + column + ") AS BIGINT)"
+}
+
+func (postgresDialect) date(column string) string {
+	return "to_char(" + column + " AT TIME ZONE 'UTC', 'YYYY-MM-DD')"
+}
+
+// Inserting an explicit ID doesn't move the identity sequence on, so it is set past the largest ID
+func (postgresDialect) resetIDs(db *database, table string) error {
+	_, err := db.Exec(fmt.Sprintf(
+		`SELECT setval(pg_get_serial_sequence('%[1]s', 'id'), COALESCE((SELECT MAX(id) FROM %[1]s), 0) + 1, false)`,
+		table,
+	))
+	return err
+}
+
+// None of the PostgreSQL migrations require anything the database might not support
+func (postgresDialect) supports(db *database, feature string) (bool, error) {
+	return false, fmt.Errorf("unknown migration requirement %q", feature)
+}
+
+// PostgreSQL can always search, the indexes only make it faster
+func (postgresDialect) searchable(db *database) (bool, error) {
+	return true, nil
+}
+
+// Ranks from each table are scaled against the best match from the same table before they are merged
+func (postgresDialect) searchQuery() string {
+	return `
+		SELECT type, id, name, snippet, COALESCE(score / NULLIF(MAX(score) OVER (PARTITION BY type), 0), 0), race_id FROM (
+			SELECT 'RACE' AS type, races.id AS id, races.name AS name, ts_headline('simple', races.name, search, 'StartSel=<b>, StopSel=</b>, HighlightAll=true') AS snippet, ts_rank(to_tsvector('simple', races.name), search) AS score, races.id AS race_id
+			FROM races, to_tsquery('simple', ?) search
+			WHERE to_tsvector('simple', races.name) @@ search
+			UNION ALL
+			SELECT 'MEETING', meetings.id, meetings.venue, ts_headline('simple', meetings.venue, search, 'StartSel=<b>, StopSel=</b>, HighlightAll=true'), ts_rank(to_tsvector('simple', meetings.venue), search), 0
+			FROM meetings, to_tsquery('simple', ?) search
+			WHERE to_tsvector('simple', meetings.venue) @@ search
+			UNION ALL
+			SELECT 'RUNNER', runners.id, runners.name, ts_headline('simple', runners.name, search, 'StartSel=<b>, StopSel=</b>, HighlightAll=true'), ts_rank(to_tsvector('simple', runners.name), search), runners.race_id
+			FROM runners, to_tsquery('simple', ?) search
+			WHERE to_tsvector('simple', runners.name) @@ search
+		) matches
+		ORDER BY 5 DESC, 1, 2
+		LIMIT ?
+	`
+}
+
+// Quotes each word as a lexeme so punctuation can't be read as tsquery syntax, matching the last as a prefix
+func (postgresDialect) searchMatch(text string) string {
+	words := strings.Fields(text)
+	for i, word := range words {
+		words[i] = "'" + strings.NewReplacer(`'`, `''`, `\`, `\\`).Replace(word) + "'"
+	}
+	if len(words) > 0 {
+		words[len(words)-1] += ":*"
+	}
+
+	return strings.Join(words, " & ")
+}
@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// Returns the current price of each runner in a race
+func (r *racesRepo) ListPrices(ctx context.Context, raceID int64) ([]*racing.Price, error) {
+	rows, err := r.db.QueryContext(ctx, getPriceQueries()[pricesList]+" WHERE race_id = ? ORDER BY runner_id", raceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanPrices(rows)
+}
+
+// Returns the price fluctuations for a race oldest first, for a single runner if runnerID is set
+func (r *racesRepo) ListPriceHistory(ctx context.Context, raceID, runnerID int64) ([]*racing.Price, error) {
+	query := getPriceQueries()[priceHistory] + " WHERE race_id = ?"
+	args := []interface{}{raceID}
+
+	if runnerID != 0 {
+		query += " AND runner_id = ?"
+		args = append(args, runnerID)
+	}
+	query += " ORDER BY updated_at, runner_id"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanPrices(rows)
+}
+
+// Scans the SQL database and returns prices
+func (r *racesRepo) scanPrices(
+	rows *sql.Rows,
+) ([]*racing.Price, error) {
+	var prices []*racing.Price
+
+	for rows.Next() {
+		var price racing.Price
+		var updatedAt time.Time
+
+		if err := rows.Scan(
+			&price.RaceId,
+			&price.RunnerId,
+			&price.WinFixed,
+			&price.PlaceFixed,
+			&price.WinTote,
+			&price.PlaceTote,
+			&updatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		ts, err := ptypes.TimestampProto(updatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		price.UpdatedAt = ts
+
+		prices = append(prices, &price)
+	}
+
+	return prices, rows.Err()
+}
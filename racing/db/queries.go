@@ -1,35 +1,141 @@
 package db
 
 const (
-	racesList  = "list"
-	sportsList = "list"
+	racesList     = "list"
+	runnersList   = "list"
+	meetingsList  = "list"
+	placingsList  = "placings"
+	dividendsList = "dividends"
+	pricesList    = "list"
+	priceHistory  = "history"
+	searchAll     = "all"
 )
 
 func getRaceQueries() map[string]string {
 	return map[string]string{
 		racesList: `
 			SELECT 
-				id, 
-				meeting_id, 
-				name, 
-				number, 
-				visible, 
-				advertised_start_time 
+				races.id, 
+				races.meeting_id, 
+				races.name, 
+				races.number, 
+				races.visible, 
+				races.advertised_start_time, 
+				races.status, 
+				races.version 
 			FROM races
 		`,
 	}
 }
 
-func getSportQueries() map[string]string {
+func getRunnerQueries() map[string]string {
 	return map[string]string{
-		sportsList: `
+		runnersList: `
 			SELECT 
 				id, 
+				race_id, 
+				saddle_number, 
 				name, 
-				advertised_start_time , 
-				sport, 
-				current_score
-			FROM sports
+				barrier, 
+				jockey, 
+				trainer, 
+				weight, 
+				scratched 
+			FROM runners
+			WHERE race_id = ?
+			ORDER BY saddle_number
+		`,
+	}
+}
+
+func getMeetingQueries() map[string]string {
+	return map[string]string{
+		meetingsList: `
+			SELECT 
+				id, 
+				venue, 
+				state, 
+				country, 
+				category, 
+				date, 
+				track_condition, 
+				weather 
+			FROM meetings
+		`,
+	}
+}
+
+func getResultQueries() map[string]string {
+	return map[string]string{
+		placingsList: `
+			SELECT 
+				results.race_id, 
+				results.runner_id, 
+				runners.saddle_number, 
+				runners.name, 
+				results.position, 
+				results.margin 
+			FROM results
+			JOIN runners ON runners.id = results.runner_id
+		`,
+		dividendsList: `
+			SELECT 
+				race_id, 
+				bet_type, 
+				saddle_numbers, 
+				amount 
+			FROM dividends
+		`,
+	}
+}
+
+func getPriceQueries() map[string]string {
+	return map[string]string{
+		pricesList: `
+			SELECT 
+				race_id, 
+				runner_id, 
+				win_fixed, 
+				place_fixed, 
+				win_tote, 
+				place_tote, 
+				updated_at 
+			FROM prices
+		`,
+		priceHistory: `
+			SELECT 
+				race_id, 
+				runner_id, 
+				win_fixed, 
+				place_fixed, 
+				win_tote, 
+				place_tote, 
+				updated_at 
+			FROM price_history
+		`,
+	}
+}
+
+func getSearchQueries() map[string]string {
+	return map[string]string{
+		// Scores are negated bm25 ranks, so higher is better. Ranks from different indexes can't be
+		// compared, so each is scaled against the best match from the same index before they are merged.
+		searchAll: `
+			SELECT type, id, name, snippet, score / MAX(score) OVER (PARTITION BY type), race_id FROM (
+				SELECT 'RACE' AS type, races.id AS id, races.name AS name, snippet(races_search, 0, '<b>', '</b>', '...', 16) AS snippet, -bm25(races_search) AS score, races.id AS race_id
+				FROM races_search JOIN races ON races.id = races_search.rowid
+				WHERE races_search MATCH ?
+				UNION ALL
+				SELECT 'MEETING', meetings.id, meetings.venue, snippet(meetings_search, 0, '<b>', '</b>', '...', 16), -bm25(meetings_search), 0
+				FROM meetings_search JOIN meetings ON meetings.id = meetings_search.rowid
+				WHERE meetings_search MATCH ?
+				UNION ALL
+				SELECT 'RUNNER', runners.id, runners.name, snippet(runners_search, 0, '<b>', '</b>', '...', 16), -bm25(runners_search), runners.race_id
+				FROM runners_search JOIN runners ON runners.id = runners_search.rowid
+				WHERE runners_search MATCH ?
+			) matches
+			ORDER BY 5 DESC, 1, 2
+			LIMIT ?
 		`,
 	}
 }
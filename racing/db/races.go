@@ -1,302 +1,392 @@
 package db
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
+	"math/rand"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
-	"github.com/sibeyzoran/EntainGroupTest/racing/proto/sports"
 )
 
 // RacesRepo provides repository access to races.
 type RacesRepo interface {
-	// Init will initialise our races repository.
-	Init() error
+	// Init will initialise our races repository, seeding it with the options given.
+	Init(seeding SeedOptions) error
 
 	// List will return a list of races.
-	List(filter *racing.ListRacesRequestFilter) ([]*racing.Race, error)
-	// GetByID will return a single race based on the ID provided
-	GetByID(id int64) (*racing.Race, error)
-	// List Sports will return a list of sports
-	ListSports(filter *sports.ListSportsRequestFilter) ([]*sports.SportEvent, error)
-	// GetSportByID will return a single sport event based on the ID provided
-	GetSportEventByID(id int64) (*sports.SportEvent, error)
+	List(ctx context.Context, filter *racing.ListRacesRequestFilter) ([]*racing.Race, error)
+	// ListPage will return a page of races and the token for the next page, which is empty on the last page
+	ListPage(ctx context.Context, filter *racing.ListRacesRequestFilter, pageSize int32, pageToken string) ([]*racing.Race, string, error)
+	// GetByID will return a single race based on the ID provided, or ErrNotFound if there is none
+	GetByID(ctx context.Context, id int64) (*racing.Race, error)
+	// TransitionStatus will move a race to a new status, returning ErrInvalidTransition if it can't
+	TransitionStatus(ctx context.Context, id int64, status racing.Race_Status) (*racing.Race, error)
+	// Create will add a new race, returning it with its ID and version filled in
+	Create(ctx context.Context, race *racing.Race) (*racing.Race, error)
+	// Update will change the fields of a race named, returning ErrVersionConflict if the race has
+	// moved on from the version given. A version of zero skips the check.
+	Update(ctx context.Context, race *racing.Race, fields []string) (*racing.Race, error)
+	// Delete will remove a race along with its runners, results and prices, returning
+	// ErrVersionConflict if the race has moved on from the version given. A version of zero skips the check.
+	Delete(ctx context.Context, id, version int64) error
+	// ListResults will return the results of the race IDs provided, keyed by race ID
+	ListResults(ctx context.Context, raceIDs []int64) (map[int64]*racing.RaceResult, error)
+	// ListRunners will return the field of runners for the race ID provided
+	ListRunners(ctx context.Context, raceID int64) ([]*racing.Runner, error)
+	// ListPrices will return the current prices for the runners in the race ID provided
+	ListPrices(ctx context.Context, raceID int64) ([]*racing.Price, error)
+	// ListPriceHistory will return every price recorded for a race, optionally limited to a single runner
+	ListPriceHistory(ctx context.Context, raceID, runnerID int64) ([]*racing.Price, error)
+	// ListMeetings will return a list of meetings
+	ListMeetings(ctx context.Context, filter *racing.ListMeetingsRequestFilter) ([]*racing.Meeting, error)
+	// GetMeeting will return a single meeting based on the ID provided, or ErrNotFound if there is none
+	GetMeeting(ctx context.Context, id int64) (*racing.Meeting, error)
+	// Search will return the races, meetings and runners best matching the text, returning
+	// ErrSearchUnavailable if SQLite was built without FTS5
+	Search(ctx context.Context, text string, count int) ([]*racing.SearchResult, error)
+	// BulkImport will create or update races or meetings by their external ID from a CSV or NDJSON
+	// file, reporting the rows it left out. A dry run stores nothing.
+	BulkImport(ctx context.Context, kind racing.BulkImportRequest_Kind, format racing.ImportFormat, data string, dryRun bool) (*racing.BulkImportResponse, error)
 }
 
 type racesRepo struct {
-	db   *sql.DB
-	init sync.Once
+	db      *database
+	dialect dialect
+	init    sync.Once
+	// searchable is set once the full-text indexes are ready
+	searchable bool
+	// seeding is what Init seeds, with random numbers made up from its Seed
+	seeding SeedOptions
+	random  *rand.Rand
 }
 
-// NewRacesRepo creates a new races repository.
+// NewRacesRepo creates a new races repository stored in SQLite.
 func NewRacesRepo(db *sql.DB) RacesRepo {
-	return &racesRepo{db: db}
+	return newRacesRepo(db, sqliteDialect{})
 }
 
-// Init prepares the race repository dummy data.
-func (r *racesRepo) Init() error {
+// NewPostgresRacesRepo creates a new races repository stored in PostgreSQL.
+func NewPostgresRacesRepo(db *sql.DB) RacesRepo {
+	return newRacesRepo(db, postgresDialect{})
+}
+
+func newRacesRepo(db *sql.DB, dialect dialect) *racesRepo {
+	return &racesRepo{db: &database{DB: db, dialect: dialect}, dialect: dialect}
+}
+
+// Init prepares the race repository dummy data. The schema has to be migrated first.
+func (r *racesRepo) Init(seeding SeedOptions) error {
+	if err := seeding.validate(); err != nil {
+		return err
+	}
+
 	var err error
 
 	r.init.Do(func() {
+		r.seeding = seeding
+
+		err = r.checkSearch()
+
 		// For test/example purposes, we seed the DB with some dummy races.
-		err = r.seed()
+		if err == nil {
+			err = r.seed()
+		}
 	})
 
 	return err
 }
 
-// Compiles the List of sports and applies filters if present
-func (s *racesRepo) ListSports(filter *sports.ListSportsRequestFilter) ([]*sports.SportEvent, error) {
-	var (
-		err        error
-		query      string
-		args       []interface{}
-		validField bool
-	)
-	// Create a bucket of valid fields that we can order by
-	validFields := []string{"name", "id", "sport", "current_score", "advertised_start_time"}
-
-	query = getSportQueries()[sportsList]
-	query, args = s.applySportsFilter(query, filter)
-
-	// Check if orderBy is provided in the filter
-	if filter != nil && filter.OrderBy != "" {
-		// Check if orderBy is a valid field
-		validField = false
-		for _, field := range validFields {
-			if filter.OrderBy == field {
-				validField = true
-				break
-			}
-		}
-		// If orderBy is valid we order by that field else order by advertised_start_time by default
-		if validField {
-			query += " ORDER BY " + filter.OrderBy
-		} else {
-			query += " ORDER BY advertised_start_time"
-		}
-		// Check if sorting direction is provided
-		if filter.Sort != "" {
-			if strings.ToLower(filter.Sort) == "desc" {
-				query += " DESC"
-			} else {
-				query += " ASC"
-			}
-		}
-	} else {
-		// If orderBy is not provided, order by advertised_start_time
-		query += " ORDER BY advertised_start_time"
-		// Default sorting direction
-		query += " ASC"
-	}
+// Get a race by its Id
+func (r *racesRepo) GetByID(ctx context.Context, id int64) (*racing.Race, error) {
+	// SQL Query to retrieve the race by its ID
+	query := getRaceQueries()[racesList] + " WHERE races.id = ?"
 
-	rows, err := s.db.Query(query, args...)
+	// Execute query
+	rows, err := r.db.QueryContext(ctx, query, id)
 	if err != nil {
 		return nil, err
 	}
-	sports, err := s.scanSportEvents(rows)
+	defer rows.Close()
+
+	// Scan the row and get race
+	races, err := r.scanRaces(rows)
 	if err != nil {
 		return nil, err
 	}
-	// Set current score to "0-0" for sports events with future advertised start times
-	for _, sport := range sports {
-		if time.Unix(sport.AdvertisedStartTime.GetSeconds(), 0).After(time.Now()) {
-			sport.CurrentScore = "0-0"
-		}
+	if len(races) == 0 {
+		return nil, notFound("race", id)
 	}
-	return sports, nil
+
+	return races[0], nil
 }
 
-// Get a sport by its Id
-func (r *racesRepo) GetSportEventByID(id int64) (*sports.SportEvent, error) {
-	// SQL Query to retrieve the sport by its ID
-	query := "SELECT id, name, advertised_start_time, sport, current_score  FROM sports WHERE id = ?"
+// Moves a race to a new status if the lifecycle allows it
+func (r *racesRepo) TransitionStatus(ctx context.Context, id int64, status racing.Race_Status) (*racing.Race, error) {
+	race, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// Execute query
-	row := r.db.QueryRow(query, id)
+	if !canTransition(race.Status, status) {
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidTransition, race.Status, status)
+	}
 
-	// Scan the row and get the sport event
-	var sport sports.SportEvent
-	var advertisedStart time.Time
-	err := row.Scan(&sport.Id, &sport.Name, &advertisedStart, &sport.Sport, &sport.CurrentScore)
+	// Only update if nobody else has moved the race on in the meantime
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE races SET status = ?, version = version + 1 WHERE id = ? AND (status = ? OR status IS NULL)`,
+		status.String(), id, race.Status.String(),
+	)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil // sport not found
-		}
 		return nil, err
 	}
-	// Convert advertised start time to protobuf Timestamp
-	ts, err := ptypes.TimestampProto(advertisedStart)
-	if err != nil {
+	if updated, err := result.RowsAffected(); err != nil {
 		return nil, err
+	} else if updated == 0 {
+		return nil, fmt.Errorf("%w: race %d was updated concurrently", ErrInvalidTransition, id)
 	}
-	sport.AdvertisedStartTime = ts
 
-	// Check if advertised start time is in the future and set score to 0-0 because it hasn't happened yet
-	if advertisedStart.After(time.Now()) {
-		sport.CurrentScore = "0-0"
+	setStatus(race, status)
+	race.Version++
+	return race, nil
+}
+
+// Inserts a new race, leaving its status to follow the advertised start time
+func (r *racesRepo) Create(ctx context.Context, race *racing.Race) (*racing.Race, error) {
+	var id int64
+	err := r.db.QueryRowContext(
+		ctx,
+		`INSERT INTO races(meeting_id, name, number, visible, advertised_start_time, version) VALUES (?,?,?,?,?,1) RETURNING id`,
+		race.MeetingId,
+		race.Name,
+		race.Number,
+		race.Visible,
+		race.AdvertisedStartTime.AsTime().Format(time.RFC3339),
+	).Scan(&id)
+	if err != nil {
+		return nil, err
 	}
 
-	return &sport, nil
+	return r.GetByID(ctx, id)
 }
 
-// Get a race by its Id
-func (r *racesRepo) GetByID(id int64) (*racing.Race, error) {
-	// SQL Query to retrieve the race by its ID
-	query := "SELECT id, meeting_id, name, number, visible, advertised_start_time FROM races WHERE id = ?"
+// Updates the named fields of a race as long as it is still at the version given
+func (r *racesRepo) Update(ctx context.Context, race *racing.Race, fields []string) (*racing.Race, error) {
+	var (
+		sets []string
+		args []interface{}
+	)
 
-	// Execute query
-	row := r.db.QueryRow(query, id)
+	for _, field := range fields {
+		switch field {
+		case "meeting_id":
+			sets = append(sets, "meeting_id = ?")
+			args = append(args, race.MeetingId)
+		case "name":
+			sets = append(sets, "name = ?")
+			args = append(args, race.Name)
+		case "number":
+			sets = append(sets, "number = ?")
+			args = append(args, race.Number)
+		case "visible":
+			sets = append(sets, "visible = ?")
+			args = append(args, race.Visible)
+		case "advertised_start_time":
+			sets = append(sets, "advertised_start_time = ?")
+			args = append(args, race.AdvertisedStartTime.AsTime().Format(time.RFC3339))
+		default:
+			return nil, &InvalidFieldError{Field: "update_mask", Description: fmt.Sprintf("%q can't be updated", field)}
+		}
+	}
+	sets = append(sets, "version = version + 1")
 
-	// Scan the row and get race
-	var race racing.Race
-	var advertisedStart time.Time
-	err := row.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart)
+	query := "UPDATE races SET " + strings.Join(sets, ", ") + " WHERE id = ?"
+	args = append(args, race.Id)
+	if race.Version != 0 {
+		query += " AND version = ?"
+		args = append(args, race.Version)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil // race not found
-		}
 		return nil, err
 	}
-	// Convert advertised start time to protobuf Timestamp
-	ts, err := ptypes.TimestampProto(advertisedStart)
-	if err != nil {
+	if err := r.checkWritten(ctx, result, race.Id); err != nil {
 		return nil, err
 	}
-	race.AdvertisedStartTime = ts
 
-	// Update status based on advertised start time
-	if advertisedStart.Before(time.Now()) {
-		race.Status = "CLOSED"
-	} else {
-		race.Status = "OPEN"
-	}
-	return &race, nil
+	return r.GetByID(ctx, race.Id)
 }
 
-// Compiles the List of races and applies filters if present
-func (r *racesRepo) List(filter *racing.ListRacesRequestFilter) ([]*racing.Race, error) {
-	var (
-		err        error
-		query      string
-		args       []interface{}
-		validField bool
-	)
-	// Create a bucket of valid fields that we can order by
-	validFields := []string{"name", "number", "id", "meeting_id", "visible", "advertised_start_time"}
+// Deletes a race and everything recorded against it as long as it is still at the version given
+func (r *racesRepo) Delete(ctx context.Context, id, version int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
 
-	query = getRaceQueries()[racesList]
-	query, args = r.applyFilter(query, filter)
-
-	// Check if orderBy is provided in the filter
-	if filter != nil && filter.OrderBy != "" {
-		// Check if orderBy is a valid field
-		validField = false
-		for _, field := range validFields {
-			if filter.OrderBy == field {
-				validField = true
-				break
-			}
-		}
-		// If orderBy is valid we order by that field else order by advertised_start_time by default
-		if validField {
-			query += " ORDER BY " + filter.OrderBy
-		} else {
-			query += " ORDER BY advertised_start_time"
-		}
-		// Check if sorting direction is provided
-		if filter.Sort != "" {
-			if strings.ToLower(filter.Sort) == "desc" {
-				query += " DESC"
-			} else {
-				query += " ASC"
-			}
-		}
-	} else {
-		// If orderBy is not provided, order by advertised_start_time
-		query += " ORDER BY advertised_start_time"
-		// Default sorting direction
-		query += " ASC"
+	query := "DELETE FROM races WHERE id = ?"
+	args := []interface{}{id}
+	if version != 0 {
+		query += " AND version = ?"
+		args = append(args, version)
 	}
 
-	rows, err := r.db.Query(query, args...)
+	result, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	races, err := r.scanRaces(rows)
-	if err != nil {
-		return nil, err
+	if err := r.checkWritten(ctx, result, id); err != nil {
+		return err
 	}
-	// Update status based on advertised start time
-	for _, race := range races {
-		advertisedStart := time.Unix(race.AdvertisedStartTime.Seconds, int64(race.AdvertisedStartTime.Nanos))
-		if advertisedStart.Before(time.Now()) {
-			race.Status = "CLOSED"
-		} else {
-			race.Status = "OPEN"
+
+	for _, table := range []string{"runners", "results", "dividends", "prices", "price_history"} {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+table+" WHERE race_id = ?", id); err != nil {
+			return err
 		}
 	}
 
-	return races, nil
+	return tx.Commit()
 }
 
-// Applies filters for sports and returns a SQL query
-func (s *racesRepo) applySportsFilter(query string, filter *sports.ListSportsRequestFilter) (string, []interface{}) {
+// Works out why a write to a race didn't touch any rows, either it doesn't exist or it has
+// moved on to a newer version
+func (r *racesRepo) checkWritten(ctx context.Context, result sql.Result, id int64) error {
+	written, err := result.RowsAffected()
+	if err != nil || written > 0 {
+		return err
+	}
+
+	var version int64
+	err = r.db.QueryRowContext(ctx, "SELECT version FROM races WHERE id = ?", id).Scan(&version)
+	if err == sql.ErrNoRows {
+		return notFound("race", id)
+	}
+	if err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: race %d is at version %d", ErrVersionConflict, id, version)
+}
+
+// Compiles the List of races and applies filters if present
+func (r *racesRepo) List(ctx context.Context, filter *racing.ListRacesRequestFilter) ([]*racing.Race, error) {
+	races, _, err := r.ListPage(ctx, filter, 0, "")
+	return races, err
+}
+
+// Compiles a page of races after the page token, if any, and applies filters if present.
+// A pageSize of zero returns every race.
+func (r *racesRepo) ListPage(ctx context.Context, filter *racing.ListRacesRequestFilter, pageSize int32, pageToken string) ([]*racing.Race, string, error) {
 	var (
-		clauses []string
+		query   string
 		args    []interface{}
+		clauses []string
+		orderBy string
+		sort    string
+		fields  []orderField
 	)
+	// Create a bucket of valid fields that we can order by
+	validFields := []string{"name", "number", "id", "meeting_id", "visible", "advertised_start_time"}
 
-	if filter == nil {
-		return query, args
+	if filter != nil {
+		orderBy, sort = filter.OrderBy, filter.Sort
+		for _, key := range filter.SortKeys {
+			fields = append(fields, orderField{field: key.Field, sort: key.Sort})
+		}
+	}
+	keys, err := orderKeys(r.dialect, "races", validFields, orderBy, sort, fields)
+	if err != nil {
+		return nil, "", err
 	}
-	// Filters via ID's - int array
-	if len(filter.Ids) > 0 {
-		clauses = append(clauses, "id IN ("+strings.Repeat("?,", len(filter.Ids)-1)+"?)")
 
-		for _, ID := range filter.Ids {
-			args = append(args, ID)
+	// Carry on from the last race on the previous page
+	if pageToken != "" {
+		token, err := decodePageToken(keys, pageToken)
+		if err != nil {
+			return nil, "", err
 		}
+
+		clause, afterArgs := afterClause(keys, token.Values)
+		clauses = append(clauses, clause)
+		args = append(args, afterArgs...)
 	}
 
-	// Filter via sport
-	if filter.Sport != "" {
-		validSports := []string{"basketball", "soccer", "hockey", "rugby league", "afl"}
-		inputSport := strings.ToLower(filter.Sport)
-		for _, sport := range validSports {
-			if inputSport == sport {
-				clauses = append(clauses, "sport = ?")
-				args = append(args, sport)
-				break
-			}
-		}
+	query = getRaceQueries()[racesList]
+	query, args = r.applyFilter(query, filter, clauses, args)
+	query += orderClause(keys)
+
+	// Fetch one extra race to find out if there's another page
+	if pageSize > 0 {
+		query += " LIMIT ?"
+		args = append(args, pageSize+1)
 	}
 
-	if len(clauses) != 0 {
-		query += " WHERE " + strings.Join(clauses, " AND ")
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
 	}
+	defer rows.Close()
 
-	return query, args
+	races, err := r.scanRaces(rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if pageSize <= 0 || len(races) <= int(pageSize) {
+		return races, "", nil
+	}
+
+	races = races[:pageSize]
+	last := races[len(races)-1]
+	values := make([]interface{}, len(keys))
+	for i, key := range keys {
+		values[i] = raceSortValue(last, key.field)
+	}
+
+	nextPageToken, err := encodePageToken(keys, values)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return races, nextPageToken, nil
 }
 
-// Applies filters and returns a SQL query
-func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFilter) (string, []interface{}) {
-	var (
-		clauses []string
-		args    []interface{}
-	)
+// Returns the value of a race for the field it is ordered by
+func raceSortValue(race *racing.Race, field string) interface{} {
+	switch field {
+	case "name":
+		return race.Name
+	case "number":
+		return race.Number
+	case "meeting_id":
+		return race.MeetingId
+	case "visible":
+		return race.Visible
+	case "advertised_start_time":
+		return race.AdvertisedStartTime.GetSeconds()
+	default:
+		return race.Id
+	}
+}
 
+// Applies filters on top of any clauses already built and returns a SQL query
+func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFilter, clauses []string, args []interface{}) (string, []interface{}) {
 	if filter == nil {
-		return query, args
+		filter = &racing.ListRacesRequestFilter{}
 	}
 	// Filters via Meeting ID - int array
 	if len(filter.MeetingIds) > 0 {
-		clauses = append(clauses, "meeting_id IN ("+strings.Repeat("?,", len(filter.MeetingIds)-1)+"?)")
+		clauses = append(clauses, "races.meeting_id IN ("+strings.Repeat("?,", len(filter.MeetingIds)-1)+"?)")
 
 		for _, meetingID := range filter.MeetingIds {
 			args = append(args, meetingID)
@@ -304,10 +394,30 @@ func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFil
 	}
 	// Filters out races with visible set to false
 	if filter.VisibleOnly {
-		clauses = append(clauses, "visible = ?")
+		clauses = append(clauses, "races.visible = ?")
 		args = append(args, true)
 	}
 
+	// Filters on the attributes of the meeting each race belongs to
+	if len(filter.MeetingCategories) > 0 || filter.MeetingDate != "" || len(filter.MeetingCountries) > 0 {
+		query += " JOIN meetings ON meetings.id = races.meeting_id"
+
+		meetingClauses, meetingArgs := meetingFilterClauses(filter.MeetingCategories, filter.MeetingDate, filter.MeetingCountries)
+		clauses = append(clauses, meetingClauses...)
+		args = append(args, meetingArgs...)
+	}
+
+	// Filters via the advertised start time window
+	startClauses, startArgs := r.startTimeClauses("races.advertised_start_time", filter.AdvertisedStartFrom, filter.AdvertisedStartTo)
+	clauses = append(clauses, startClauses...)
+	args = append(args, startArgs...)
+
+	// Filters via status, working it out from the start time for races that haven't had one stored
+	if filter.Status != racing.Race_STATUS_UNSPECIFIED {
+		clauses = append(clauses, "COALESCE(NULLIF(races.status, ''), CASE WHEN "+r.dialect.epochSeconds("races.advertised_start_time")+" < ? THEN 'CLOSED' ELSE 'OPEN' END) = ?")
+		args = append(args, time.Now().Unix(), filter.Status.String())
+	}
+
 	if len(clauses) != 0 {
 		query += " WHERE " + strings.Join(clauses, " AND ")
 	}
@@ -315,35 +425,23 @@ func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFil
 	return query, args
 }
 
-// Scans the SQL database and returns sport events
-func (m *racesRepo) scanSportEvents(
-	rows *sql.Rows,
-) ([]*sports.SportEvent, error) {
-	var sportEvents []*sports.SportEvent
-
-	for rows.Next() {
-		var sport sports.SportEvent
-		var advertisedStart time.Time
-
-		if err := rows.Scan(&sport.Id, &sport.Name, &advertisedStart, &sport.Sport, &sport.CurrentScore); err != nil {
-			if err == sql.ErrNoRows {
-				return nil, nil
-			}
-
-			return nil, err
-		}
-
-		ts, err := ptypes.TimestampProto(advertisedStart)
-		if err != nil {
-			return nil, err
-		}
-
-		sport.AdvertisedStartTime = ts
+// Builds the WHERE clauses for an advertised start time window, from inclusive and to exclusive
+func (r *racesRepo) startTimeClauses(column string, from, to *timestamppb.Timestamp) ([]string, []interface{}) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
 
-		sportEvents = append(sportEvents, &sport)
+	if from != nil {
+		clauses = append(clauses, r.dialect.epochSeconds(column)+" >= ?")
+		args = append(args, from.GetSeconds())
+	}
+	if to != nil {
+		clauses = append(clauses, r.dialect.epochSeconds(column)+" < ?")
+		args = append(args, to.GetSeconds())
 	}
 
-	return sportEvents, nil
+	return clauses, args
 }
 
 // Scans the SQL database and returns races
@@ -355,8 +453,9 @@ func (m *racesRepo) scanRaces(
 	for rows.Next() {
 		var race racing.Race
 		var advertisedStart time.Time
+		var status sql.NullString
 
-		if err := rows.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart); err != nil {
+		if err := rows.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart, &status, &race.Version); err != nil {
 			if err == sql.ErrNoRows {
 				return nil, nil
 			}
@@ -370,9 +469,10 @@ func (m *racesRepo) scanRaces(
 		}
 
 		race.AdvertisedStartTime = ts
+		setStatus(&race, raceStatus(status, advertisedStart))
 
 		races = append(races, &race)
 	}
 
-	return races, nil
+	return races, rows.Err()
 }
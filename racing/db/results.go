@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// Returns the results of the races provided, keyed by race ID. Races that haven't been resulted are left out.
+func (r *racesRepo) ListResults(ctx context.Context, raceIDs []int64) (map[int64]*racing.RaceResult, error) {
+	results := make(map[int64]*racing.RaceResult)
+	if len(raceIDs) == 0 {
+		return results, nil
+	}
+
+	args := make([]interface{}, len(raceIDs))
+	for i, raceID := range raceIDs {
+		args[i] = raceID
+	}
+	in := "(" + strings.Repeat("?,", len(raceIDs)-1) + "?)"
+
+	// Results for a race are built up from its placings and then its dividends
+	resultFor := func(raceID int64) *racing.RaceResult {
+		if _, ok := results[raceID]; !ok {
+			results[raceID] = &racing.RaceResult{RaceId: raceID}
+		}
+		return results[raceID]
+	}
+
+	rows, err := r.db.QueryContext(ctx, getResultQueries()[placingsList]+" WHERE results.race_id IN "+in+" ORDER BY results.race_id, results.position, runners.saddle_number", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			raceID  int64
+			placing racing.Placing
+		)
+
+		if err := rows.Scan(&raceID, &placing.RunnerId, &placing.SaddleNumber, &placing.RunnerName, &placing.Position, &placing.Margin); err != nil {
+			return nil, err
+		}
+
+		resultFor(raceID).Placings = append(resultFor(raceID).Placings, &placing)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = r.db.QueryContext(ctx, getResultQueries()[dividendsList]+" WHERE race_id IN "+in+" ORDER BY race_id, id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			raceID        int64
+			betType       string
+			saddleNumbers string
+			dividend      racing.Dividend
+		)
+
+		if err := rows.Scan(&raceID, &betType, &saddleNumbers, &dividend.Amount); err != nil {
+			return nil, err
+		}
+
+		dividend.BetType = racing.Dividend_BetType(racing.Dividend_BetType_value[betType])
+		// Combinations are stored as comma separated saddle numbers e.g. "3,7"
+		for _, number := range strings.Split(saddleNumbers, ",") {
+			saddleNumber, err := strconv.ParseInt(number, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			dividend.SaddleNumbers = append(dividend.SaddleNumbers, saddleNumber)
+		}
+
+		resultFor(raceID).Dividends = append(resultFor(raceID).Dividends, &dividend)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Runners sharing a position dead heated
+	for _, result := range results {
+		shared := make(map[int64]int)
+		for _, placing := range result.Placings {
+			shared[placing.Position]++
+		}
+		for _, placing := range result.Placings {
+			placing.DeadHeat = shared[placing.Position] > 1
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"testing"
+)
+
+// Runners sharing a position dead heated, so there is nothing between them
+func TestSeededDeadHeatsShareAMargin(t *testing.T) {
+	racingDB := openSQLite(t, "sqlite3")
+	if _, err := NewMigrator(racingDB).Up(context.Background(), 0); err != nil {
+		t.Fatalf("migrating the database: %s", err)
+	}
+
+	// Enough races that some of them are dead heats
+	seeding := testSeedOptions()
+	seeding.Races = 40
+	repo := newRacesRepo(racingDB, sqliteDialect{})
+	if err := repo.Init(seeding); err != nil {
+		t.Fatalf("seeding the database: %s", err)
+	}
+
+	raceIDs := make([]int64, seeding.Races)
+	for i := range raceIDs {
+		raceIDs[i] = int64(i + 1)
+	}
+	results, err := repo.ListResults(context.Background(), raceIDs)
+	if err != nil {
+		t.Fatalf("ListResults() returned error %s", err)
+	}
+
+	var deadHeats int
+	for raceID, result := range results {
+		margins := make(map[int64]float64)
+		for _, placing := range result.Placings {
+			if !placing.DeadHeat {
+				continue
+			}
+			deadHeats++
+			if margin, ok := margins[placing.Position]; ok && margin != placing.Margin {
+				t.Errorf("race %d has runners dead heating for %d by margins of %.1f and %.1f, want the same margin", raceID, placing.Position, margin, placing.Margin)
+			}
+			margins[placing.Position] = placing.Margin
+		}
+	}
+	if deadHeats == 0 {
+		t.Fatalf("seeded %d races without a dead heat, want some to check", seeding.Races)
+	}
+}
@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// Returns the runners entered in a race ordered by saddle number
+func (r *racesRepo) ListRunners(ctx context.Context, raceID int64) ([]*racing.Runner, error) {
+	rows, err := r.db.QueryContext(ctx, getRunnerQueries()[runnersList], raceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanRunners(rows)
+}
+
+// Scans the SQL database and returns runners
+func (r *racesRepo) scanRunners(
+	rows *sql.Rows,
+) ([]*racing.Runner, error) {
+	var runners []*racing.Runner
+
+	for rows.Next() {
+		var runner racing.Runner
+
+		if err := rows.Scan(
+			&runner.Id,
+			&runner.RaceId,
+			&runner.SaddleNumber,
+			&runner.Name,
+			&runner.Barrier,
+			&runner.Jockey,
+			&runner.Trainer,
+			&runner.Weight,
+			&runner.Scratched,
+		); err != nil {
+			return nil, err
+		}
+
+		runners = append(runners, &runner)
+	}
+
+	return runners, rows.Err()
+}
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// ErrSearchUnavailable is returned when searching races stored in SQLite built without FTS5,
+// i.e. without the sqlite_fts5 tag.
+var ErrSearchUnavailable = errors.New("search needs SQLite built with FTS5, build with -tags sqlite_fts5")
+
+// Works out whether search can be used
+func (r *racesRepo) checkSearch() error {
+	searchable, err := r.dialect.searchable(r.db)
+	r.searchable = searchable
+	return err
+}
+
+// Search returns the races, meetings and runners whose names best match the text given, up to
+// count of them. Every word has to match and the last can be the start of a word, so results
+// come back as the text is typed.
+func (r *racesRepo) Search(ctx context.Context, text string, count int) ([]*racing.SearchResult, error) {
+	if !r.searchable {
+		return nil, ErrSearchUnavailable
+	}
+
+	match := r.dialect.searchMatch(text)
+	rows, err := r.db.QueryContext(ctx, r.dialect.searchQuery(), match, match, match, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*racing.SearchResult
+	for rows.Next() {
+		var (
+			result     racing.SearchResult
+			resultType string
+		)
+		if err := rows.Scan(&resultType, &result.Id, &result.Name, &result.Snippet, &result.Score, &result.RaceId); err != nil {
+			return nil, err
+		}
+		result.Type = racing.SearchResult_Type(racing.SearchResult_Type_value[resultType])
+
+		results = append(results, &result)
+	}
+
+	return results, rows.Err()
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sqliteDialect stores races in SQLite, the default
+type sqliteDialect struct{}
+
+func (sqliteDialect) rebind(query string) string {
+	return query
+}
+
+func (sqliteDialect) migrations() string {
+	return "migrations/sqlite"
+}
+
+func (sqliteDialect) tableQuery() string {
+	return `SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?`
+}
+
+// Times can be stored with different offsets, so they have to be compared this way rather than as text
+func (sqliteDialect) epochSeconds(column string) string {
+	return "CAST(strftime('%s', " + column + ") AS INTEGER)"
+}
+
+func (sqliteDialect) date(column string) string {
+	return "date(" + column + ")"
+}
+
+// SQLite carries on from the largest ID in the table by itself
+func (sqliteDialect) resetIDs(db *database, table string) error {
+	return nil
+}
+
+// SQLite can be built without FTS5, which full-text search needs
+func (sqliteDialect) supports(db *database, feature string) (bool, error) {
+	if feature != "fts5" {
+		return false, fmt.Errorf("unknown migration requirement %q", feature)
+	}
+
+	var supported bool
+	err := db.QueryRow(`SELECT sqlite_compileoption_used('ENABLE_FTS5')`).Scan(&supported)
+	return supported, err
+}
+
+// Search can be used once the migration creating the full-text indexes has been applied, which
+// needs SQLite built with FTS5
+func (d sqliteDialect) searchable(db *database) (bool, error) {
+	var indexes int
+	if err := db.QueryRow(d.tableQuery(), "races_search").Scan(&indexes); err != nil || indexes == 0 {
+		return false, err
+	}
+
+	return d.supports(db, "fts5")
+}
+
+func (sqliteDialect) searchQuery() string {
+	return getSearchQueries()[searchAll]
+}
+
+// Quotes each word so punctuation can't be read as FTS5 query syntax, matching the last as a prefix
+func (sqliteDialect) searchMatch(text string) string {
+	words := strings.Fields(text)
+	for i, word := range words {
+		words[i] = `"` + strings.ReplaceAll(word, `"`, `""`) + `"`
+	}
+	if len(words) > 0 {
+		words[len(words)-1] += "*"
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// ErrInvalidTransition is returned when a race can't move from its current status to the one requested.
+var ErrInvalidTransition = errors.New("invalid race status transition")
+
+// The statuses a race is allowed to move to from each status
+var raceTransitions = map[racing.Race_Status][]racing.Race_Status{
+	racing.Race_OPEN:      {racing.Race_SUSPENDED, racing.Race_CLOSED, racing.Race_ABANDONED, racing.Race_POSTPONED},
+	racing.Race_SUSPENDED: {racing.Race_OPEN, racing.Race_CLOSED, racing.Race_ABANDONED, racing.Race_POSTPONED},
+	racing.Race_CLOSED:    {racing.Race_INTERIM, racing.Race_FINAL, racing.Race_ABANDONED},
+	racing.Race_INTERIM:   {racing.Race_FINAL},
+	racing.Race_POSTPONED: {racing.Race_OPEN, racing.Race_ABANDONED},
+	// FINAL and ABANDONED races are done with
+}
+
+// Reports whether a race can move from one status to another
+func canTransition(from, to racing.Race_Status) bool {
+	for _, status := range raceTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sets the status of a race, along with its name in the string field older clients read it from
+func setStatus(race *racing.Race, status racing.Race_Status) {
+	race.Status = status
+	race.LegacyStatus = status.String()
+}
+
+// Works out the status of a race, falling back to the advertised start time when none is stored
+func raceStatus(stored sql.NullString, advertisedStart time.Time) racing.Race_Status {
+	if status, ok := racing.Race_Status_value[stored.String]; ok && stored.Valid && status != 0 {
+		return racing.Race_Status(status)
+	}
+
+	if advertisedStart.Before(time.Now()) {
+		return racing.Race_CLOSED
+	}
+
+	return racing.Race_OPEN
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// Clients built from before status was an enum read field 7 of a race as the name of its status
+func TestStatusKeepsTheLegacyWireFormat(t *testing.T) {
+	race := &racing.Race{Id: 1}
+	setStatus(race, racing.Race_FINAL)
+
+	encoded, err := proto.Marshal(race)
+	if err != nil {
+		t.Fatalf("marshalling the race: %s", err)
+	}
+
+	var (
+		legacy string
+		found  bool
+	)
+	for len(encoded) > 0 {
+		number, wireType, n := protowire.ConsumeTag(encoded)
+		if n < 0 {
+			t.Fatalf("reading a tag: %s", protowire.ParseError(n))
+		}
+		encoded = encoded[n:]
+
+		if number == 7 {
+			if wireType != protowire.BytesType {
+				t.Fatalf("field 7 has wire type %d, want %d, a string", wireType, protowire.BytesType)
+			}
+			value, n := protowire.ConsumeString(encoded)
+			if n < 0 {
+				t.Fatalf("reading field 7: %s", protowire.ParseError(n))
+			}
+			legacy, found = value, true
+		}
+
+		n = protowire.ConsumeFieldValue(number, wireType, encoded)
+		if n < 0 {
+			t.Fatalf("reading field %d: %s", number, protowire.ParseError(n))
+		}
+		encoded = encoded[n:]
+	}
+
+	if !found || legacy != "FINAL" {
+		t.Fatalf("field 7 is %q, found %t, want the string FINAL", legacy, found)
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// Handles racing import, which creates or updates races or meetings from a file without serving:
+//
+//	racing import [--dry-run] [--format csv|ndjson] races|meetings FILE
+//
+// The format is taken from the file's extension when not given. Meetings have to be imported
+// before the races held at them.
+func importFile(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "Check the file and report what would be imported without storing anything")
+	formatName := flags.String("format", "", "Format of the file, csv or ndjson, taken from its extension when empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return fmt.Errorf("usage: racing import [--dry-run] [--format csv|ndjson] races|meetings FILE")
+	}
+
+	kind, ok := racing.BulkImportRequest_Kind_value[strings.ToUpper(flags.Arg(0))]
+	if !ok || kind == 0 {
+		return fmt.Errorf("unknown kind %q, use races or meetings", flags.Arg(0))
+	}
+	file := flags.Arg(1)
+	format, err := importFormat(*formatName, file)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	racingDB, err := sql.Open(*dbDriver, *dbSource)
+	if err != nil {
+		return err
+	}
+	defer racingDB.Close()
+
+	// Imported rows need the schema up to date, as serving does
+	ctx := context.Background()
+	if err := migrateUp(ctx, storages[*dbDriver].newMigrator(racingDB), 0); err != nil {
+		return err
+	}
+
+	response, err := storages[*dbDriver].newRacesRepo(racingDB).BulkImport(ctx, racing.BulkImportRequest_Kind(kind), format, string(data), *dryRun)
+	if err != nil {
+		return err
+	}
+
+	for _, importErr := range response.Errors {
+		fmt.Println(describeImportError(file, importErr))
+	}
+	summary := "imported"
+	if response.DryRun {
+		summary = "dry run, nothing stored:"
+	}
+	fmt.Printf("%s %d created, %d updated, %d failed\n", summary, response.Created, response.Updated, response.Failed)
+	if response.Failed > 0 {
+		return fmt.Errorf("%d rows of %s couldn't be imported", response.Failed, file)
+	}
+
+	return nil
+}
+
+// Picks the format of a file from the flag given, or failing that its extension
+func importFormat(name, file string) (racing.ImportFormat, error) {
+	if name == "" {
+		switch strings.ToLower(filepath.Ext(file)) {
+		case ".csv":
+			name = "csv"
+		case ".ndjson", ".jsonl":
+			name = "ndjson"
+		default:
+			return 0, fmt.Errorf("can't tell the format of %s from its extension, use --format csv or --format ndjson", file)
+		}
+	}
+
+	format, ok := racing.ImportFormat_value[strings.ToUpper(name)]
+	if !ok || format == 0 {
+		return 0, fmt.Errorf("unknown format %q, use csv or ndjson", name)
+	}
+
+	return racing.ImportFormat(format), nil
+}
+
+// Describes a row that couldn't be imported as file:line, its external ID if it has one, then the problem
+func describeImportError(file string, importErr *racing.ImportError) string {
+	description := fmt.Sprintf("%s:%d:", file, importErr.Line)
+	if importErr.ExternalId != "" {
+		description += fmt.Sprintf(" %s", importErr.ExternalId)
+	}
+	if importErr.Field != "" {
+		description += fmt.Sprintf(" %s", importErr.Field)
+	}
+
+	return fmt.Sprintf("%s %s", description, importErr.Description)
+}
@@ -1,27 +1,69 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"flag"
+	"fmt"
 	"log"
 	"net"
+	"time"
 
 	"github.com/sibeyzoran/EntainGroupTest/racing/db"
 	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
-	"github.com/sibeyzoran/EntainGroupTest/racing/proto/sports"
 	"github.com/sibeyzoran/EntainGroupTest/racing/service"
 	"google.golang.org/grpc"
 )
 
 var (
 	grpcEndpoint = flag.String("grpc-endpoint", "localhost:9000", "gRPC server endpoint")
+	dbDriver     = flag.String("db-driver", "sqlite3", "Database to store races in, sqlite3 or postgres")
+	dbSource     = flag.String("db-source", "./db/racing.db", "Database to connect to, a file for sqlite3 or a connection string for postgres")
 )
 
+// Flags for the dummy data seeded on start up, which the same flags always seed the same way
+var (
+	defaultSeeding = db.DefaultSeedOptions()
+
+	seed         = flag.Int64("seed", 0, "Seed for the random dummy data, picked from the clock when 0")
+	seedTime     = flag.String("seed-time", "", "Time the dummy races are seeded around in RFC 3339, e.g. 2024-01-01T12:00:00Z, now when empty")
+	seedFrom     = flag.Duration("seed-from", defaultSeeding.From, "Earliest a dummy race starts, relative to --seed-time")
+	seedTo       = flag.Duration("seed-to", defaultSeeding.To, "Latest a dummy race starts, relative to --seed-time")
+	seedRaces    = flag.Int("seed-races", defaultSeeding.Races, "Number of dummy races to seed")
+	seedMeetings = flag.Int("seed-meetings", defaultSeeding.Meetings, "Number of meetings to spread the dummy races across")
+	seedReset    = flag.Bool("seed-reset", false, "Delete everything stored and seed again, rather than only seeding what's missing")
+)
+
+// The repository and migrator for each database races can be stored in
+var storages = map[string]struct {
+	newRacesRepo func(*sql.DB) db.RacesRepo
+	newMigrator  func(*sql.DB) db.Migrator
+}{
+	"sqlite3":  {db.NewRacesRepo, db.NewMigrator},
+	"postgres": {db.NewPostgresRacesRepo, db.NewPostgresMigrator},
+}
+
 func main() {
 	flag.Parse()
+	if _, ok := storages[*dbDriver]; !ok {
+		log.Fatalf("unknown db-driver %q, use sqlite3 or postgres\n", *dbDriver)
+	}
 
-	if err := run(); err != nil {
-		log.Fatalf("failed running grpc server: %s\n", err)
+	switch flag.Arg(0) {
+	case "":
+		if err := run(); err != nil {
+			log.Fatalf("failed running grpc server: %s\n", err)
+		}
+	case "migrate":
+		if err := migrate(flag.Args()[1:]); err != nil {
+			log.Fatalf("failed migrating: %s\n", err)
+		}
+	case "import":
+		if err := importFile(flag.Args()[1:]); err != nil {
+			log.Fatalf("failed importing: %s\n", err)
+		}
+	default:
+		log.Fatalf("unknown command %q, run with no command to serve, migrate to manage the schema or import to load a file\n", flag.Arg(0))
 	}
 }
 
@@ -31,13 +73,23 @@ func run() error {
 		return err
 	}
 
-	racingDB, err := sql.Open("sqlite3", "./db/racing.db")
+	racingDB, err := sql.Open(*dbDriver, *dbSource)
 	if err != nil {
 		return err
 	}
 
-	racesRepo := db.NewRacesRepo(racingDB)
-	if err := racesRepo.Init(); err != nil {
+	// Bring the schema up to date before seeding
+	if err := migrateUp(context.Background(), storages[*dbDriver].newMigrator(racingDB), 0); err != nil {
+		return err
+	}
+
+	seeding, err := seedOptions()
+	if err != nil {
+		return err
+	}
+
+	racesRepo := storages[*dbDriver].newRacesRepo(racingDB)
+	if err := racesRepo.Init(seeding); err != nil {
 		return err
 	}
 
@@ -50,13 +102,6 @@ func run() error {
 		),
 	)
 
-	sports.RegisterSportsServer(
-		grpcServer,
-		service.NewSportingService(
-			racesRepo,
-		),
-	)
-
 	log.Printf("gRPC server listening on: %s\n", *grpcEndpoint)
 
 	if err := grpcServer.Serve(conn); err != nil {
@@ -65,3 +110,27 @@ func run() error {
 
 	return nil
 }
+
+// Reads the seed flags, logging the seed and time picked so the same data can be seeded again
+func seedOptions() (db.SeedOptions, error) {
+	seeding := defaultSeeding
+	if *seed != 0 {
+		seeding.Seed = *seed
+	}
+	if *seedTime != "" {
+		now, err := time.Parse(time.RFC3339, *seedTime)
+		if err != nil {
+			return seeding, fmt.Errorf("invalid seed-time: %q is not an RFC 3339 time", *seedTime)
+		}
+		seeding.Now = now
+	}
+	seeding.From = *seedFrom
+	seeding.To = *seedTo
+	seeding.Races = *seedRaces
+	seeding.Meetings = *seedMeetings
+	seeding.Reset = *seedReset
+
+	log.Printf("seeding with --seed %d --seed-time %s\n", seeding.Seed, seeding.Now.UTC().Format(time.RFC3339))
+
+	return seeding, nil
+}
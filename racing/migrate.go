@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/db"
+)
+
+// Handles racing migrate, which manages the schema without serving or seeding:
+//
+//	racing migrate up [version]    applies migrations up to the version, or all of them
+//	racing migrate down [version]  undoes migrations after the version, or the latest one
+//	racing migrate status          lists the migrations and whether each is applied
+func migrate(args []string) error {
+	if len(args) == 0 || len(args) > 2 || (args[0] == "status" && len(args) > 1) {
+		return fmt.Errorf("usage: racing migrate up [version] | down [version] | status")
+	}
+
+	version := -1
+	if len(args) == 2 {
+		parsed, err := strconv.Atoi(args[1])
+		if err != nil || parsed < 0 {
+			return fmt.Errorf("invalid version: %q is not a migration number", args[1])
+		}
+		version = parsed
+	}
+
+	racingDB, err := sql.Open(*dbDriver, *dbSource)
+	if err != nil {
+		return err
+	}
+	defer racingDB.Close()
+
+	ctx := context.Background()
+	migrator := storages[*dbDriver].newMigrator(racingDB)
+
+	switch args[0] {
+	case "up":
+		// Every migration is applied when no version is given, there is no migration 0 to go up to
+		if version == 0 {
+			return fmt.Errorf("invalid version: there is no migration 0000, leave the version out to apply every migration")
+		}
+		if version < 0 {
+			version = 0
+		}
+		return migrateUp(ctx, migrator, version)
+	case "down":
+		if version < 0 {
+			if version, err = previousVersion(ctx, migrator); err != nil {
+				return err
+			}
+		}
+		migrations, err := migrator.Down(ctx, version)
+		for _, migration := range migrations {
+			log.Printf("migrated down from %04d_%s\n", migration.Version, migration.Name)
+		}
+		return err
+	case "status":
+		migrations, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, migration := range migrations {
+			state := "pending"
+			switch {
+			case migration.Applied:
+				state = "applied"
+			case migration.Unsupported:
+				state = fmt.Sprintf("pending, the database doesn't support %s", migration.Requires)
+			}
+			fmt.Printf("%04d_%s %s\n", migration.Version, migration.Name, state)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q, use up, down or status", args[0])
+	}
+}
+
+// Applies the migrations up to a version, or all of them for zero, logging each one applied and
+// any left pending as the database doesn't support them
+func migrateUp(ctx context.Context, migrator db.Migrator, version int) error {
+	migrations, err := migrator.Up(ctx, version)
+	for _, migration := range migrations {
+		log.Printf("migrated up to %04d_%s\n", migration.Version, migration.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	migrations, err = migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+	for _, migration := range migrations {
+		if migration.Unsupported && (version == 0 || migration.Version <= version) {
+			log.Printf("left %04d_%s pending, the database doesn't support %s\n", migration.Version, migration.Name, migration.Requires)
+		}
+	}
+
+	return nil
+}
+
+// Finds the version before the latest one applied, which is where a bare down goes back to
+func previousVersion(ctx context.Context, migrator db.Migrator) (int, error) {
+	migrations, err := migrator.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var applied []int
+	for _, migration := range migrations {
+		if migration.Applied {
+			applied = append(applied, migration.Version)
+		}
+	}
+	if len(applied) < 2 {
+		return 0, nil
+	}
+
+	return applied[len(applied)-2], nil
+}
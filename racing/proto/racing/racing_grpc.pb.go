@@ -19,8 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Racing_ListRaces_FullMethodName   = "/racing.Racing/ListRaces"
-	Racing_GetRaceByID_FullMethodName = "/racing.Racing/GetRaceByID"
+	Racing_ListRaces_FullMethodName             = "/racing.Racing/ListRaces"
+	Racing_GetRaceByID_FullMethodName           = "/racing.Racing/GetRaceByID"
+	Racing_TransitionRace_FullMethodName        = "/racing.Racing/TransitionRace"
+	Racing_GetRaceResult_FullMethodName         = "/racing.Racing/GetRaceResult"
+	Racing_CreateRace_FullMethodName            = "/racing.Racing/CreateRace"
+	Racing_UpdateRace_FullMethodName            = "/racing.Racing/UpdateRace"
+	Racing_DeleteRace_FullMethodName            = "/racing.Racing/DeleteRace"
+	Racing_ListRunners_FullMethodName           = "/racing.Racing/ListRunners"
+	Racing_ListPrices_FullMethodName            = "/racing.Racing/ListPrices"
+	Racing_ListPriceFluctuations_FullMethodName = "/racing.Racing/ListPriceFluctuations"
+	Racing_ListMeetings_FullMethodName          = "/racing.Racing/ListMeetings"
+	Racing_GetMeeting_FullMethodName            = "/racing.Racing/GetMeeting"
+	Racing_NextToGo_FullMethodName              = "/racing.Racing/NextToGo"
+	Racing_WatchRaces_FullMethodName            = "/racing.Racing/WatchRaces"
+	Racing_Search_FullMethodName                = "/racing.Racing/Search"
+	Racing_BulkImport_FullMethodName            = "/racing.Racing/BulkImport"
 )
 
 // RacingClient is the client API for Racing service.
@@ -31,6 +45,35 @@ type RacingClient interface {
 	ListRaces(ctx context.Context, in *ListRacesRequest, opts ...grpc.CallOption) (*ListRacesResponse, error)
 	// GetRaceByID will return a single race
 	GetRaceByID(ctx context.Context, in *GetRaceByIDRequest, opts ...grpc.CallOption) (*GetRaceByIDResponse, error)
+	// TransitionRace will move a race to a new status
+	TransitionRace(ctx context.Context, in *TransitionRaceRequest, opts ...grpc.CallOption) (*TransitionRaceResponse, error)
+	// GetRaceResult will return the result of a race
+	GetRaceResult(ctx context.Context, in *GetRaceResultRequest, opts ...grpc.CallOption) (*GetRaceResultResponse, error)
+	// CreateRace will add a new race
+	CreateRace(ctx context.Context, in *CreateRaceRequest, opts ...grpc.CallOption) (*CreateRaceResponse, error)
+	// UpdateRace will change the fields of a race named in the update mask
+	UpdateRace(ctx context.Context, in *UpdateRaceRequest, opts ...grpc.CallOption) (*UpdateRaceResponse, error)
+	// DeleteRace will remove a race
+	DeleteRace(ctx context.Context, in *DeleteRaceRequest, opts ...grpc.CallOption) (*DeleteRaceResponse, error)
+	// ListRunners will return the field of runners for a race
+	ListRunners(ctx context.Context, in *ListRunnersRequest, opts ...grpc.CallOption) (*ListRunnersResponse, error)
+	// ListPrices will return the current prices for each runner in a race
+	ListPrices(ctx context.Context, in *ListPricesRequest, opts ...grpc.CallOption) (*ListPricesResponse, error)
+	// ListPriceFluctuations will return the price history for runners in a race
+	ListPriceFluctuations(ctx context.Context, in *ListPriceFluctuationsRequest, opts ...grpc.CallOption) (*ListPriceFluctuationsResponse, error)
+	// ListMeetings will return a collection of all race meetings.
+	ListMeetings(ctx context.Context, in *ListMeetingsRequest, opts ...grpc.CallOption) (*ListMeetingsResponse, error)
+	// GetMeeting will return a single race meeting
+	GetMeeting(ctx context.Context, in *GetMeetingRequest, opts ...grpc.CallOption) (*GetMeetingResponse, error)
+	// NextToGo will return the next races to jump
+	NextToGo(ctx context.Context, in *NextToGoRequest, opts ...grpc.CallOption) (*NextToGoResponse, error)
+	// WatchRaces will stream an event whenever a race matching the filter is
+	// added, changed or changes status.
+	WatchRaces(ctx context.Context, in *WatchRacesRequest, opts ...grpc.CallOption) (Racing_WatchRacesClient, error)
+	// Search will return the races, meetings and runners best matching the text
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// BulkImport will create or update races or meetings by their external ID from a CSV or NDJSON file
+	BulkImport(ctx context.Context, in *BulkImportRequest, opts ...grpc.CallOption) (*BulkImportResponse, error)
 }
 
 type racingClient struct {
@@ -59,18 +102,195 @@ func (c *racingClient) GetRaceByID(ctx context.Context, in *GetRaceByIDRequest,
 	return out, nil
 }
 
+func (c *racingClient) TransitionRace(ctx context.Context, in *TransitionRaceRequest, opts ...grpc.CallOption) (*TransitionRaceResponse, error) {
+	out := new(TransitionRaceResponse)
+	err := c.cc.Invoke(ctx, Racing_TransitionRace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) GetRaceResult(ctx context.Context, in *GetRaceResultRequest, opts ...grpc.CallOption) (*GetRaceResultResponse, error) {
+	out := new(GetRaceResultResponse)
+	err := c.cc.Invoke(ctx, Racing_GetRaceResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) CreateRace(ctx context.Context, in *CreateRaceRequest, opts ...grpc.CallOption) (*CreateRaceResponse, error) {
+	out := new(CreateRaceResponse)
+	err := c.cc.Invoke(ctx, Racing_CreateRace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) UpdateRace(ctx context.Context, in *UpdateRaceRequest, opts ...grpc.CallOption) (*UpdateRaceResponse, error) {
+	out := new(UpdateRaceResponse)
+	err := c.cc.Invoke(ctx, Racing_UpdateRace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) DeleteRace(ctx context.Context, in *DeleteRaceRequest, opts ...grpc.CallOption) (*DeleteRaceResponse, error) {
+	out := new(DeleteRaceResponse)
+	err := c.cc.Invoke(ctx, Racing_DeleteRace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) ListRunners(ctx context.Context, in *ListRunnersRequest, opts ...grpc.CallOption) (*ListRunnersResponse, error) {
+	out := new(ListRunnersResponse)
+	err := c.cc.Invoke(ctx, Racing_ListRunners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) ListPrices(ctx context.Context, in *ListPricesRequest, opts ...grpc.CallOption) (*ListPricesResponse, error) {
+	out := new(ListPricesResponse)
+	err := c.cc.Invoke(ctx, Racing_ListPrices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) ListPriceFluctuations(ctx context.Context, in *ListPriceFluctuationsRequest, opts ...grpc.CallOption) (*ListPriceFluctuationsResponse, error) {
+	out := new(ListPriceFluctuationsResponse)
+	err := c.cc.Invoke(ctx, Racing_ListPriceFluctuations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) ListMeetings(ctx context.Context, in *ListMeetingsRequest, opts ...grpc.CallOption) (*ListMeetingsResponse, error) {
+	out := new(ListMeetingsResponse)
+	err := c.cc.Invoke(ctx, Racing_ListMeetings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) GetMeeting(ctx context.Context, in *GetMeetingRequest, opts ...grpc.CallOption) (*GetMeetingResponse, error) {
+	out := new(GetMeetingResponse)
+	err := c.cc.Invoke(ctx, Racing_GetMeeting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) NextToGo(ctx context.Context, in *NextToGoRequest, opts ...grpc.CallOption) (*NextToGoResponse, error) {
+	out := new(NextToGoResponse)
+	err := c.cc.Invoke(ctx, Racing_NextToGo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) WatchRaces(ctx context.Context, in *WatchRacesRequest, opts ...grpc.CallOption) (Racing_WatchRacesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Racing_ServiceDesc.Streams[0], Racing_WatchRaces_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &racingWatchRacesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Racing_WatchRacesClient interface {
+	Recv() (*WatchRacesResponse, error)
+	grpc.ClientStream
+}
+
+type racingWatchRacesClient struct {
+	grpc.ClientStream
+}
+
+func (x *racingWatchRacesClient) Recv() (*WatchRacesResponse, error) {
+	m := new(WatchRacesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *racingClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, Racing_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) BulkImport(ctx context.Context, in *BulkImportRequest, opts ...grpc.CallOption) (*BulkImportResponse, error) {
+	out := new(BulkImportResponse)
+	err := c.cc.Invoke(ctx, Racing_BulkImport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RacingServer is the server API for Racing service.
-// All implementations must embed UnimplementedRacingServer
+// All implementations should embed UnimplementedRacingServer
 // for forward compatibility
 type RacingServer interface {
 	// ListRaces will return a collection of all races.
 	ListRaces(context.Context, *ListRacesRequest) (*ListRacesResponse, error)
 	// GetRaceByID will return a single race
 	GetRaceByID(context.Context, *GetRaceByIDRequest) (*GetRaceByIDResponse, error)
-	//mustEmbedUnimplementedRacingServer()
+	// TransitionRace will move a race to a new status
+	TransitionRace(context.Context, *TransitionRaceRequest) (*TransitionRaceResponse, error)
+	// GetRaceResult will return the result of a race
+	GetRaceResult(context.Context, *GetRaceResultRequest) (*GetRaceResultResponse, error)
+	// CreateRace will add a new race
+	CreateRace(context.Context, *CreateRaceRequest) (*CreateRaceResponse, error)
+	// UpdateRace will change the fields of a race named in the update mask
+	UpdateRace(context.Context, *UpdateRaceRequest) (*UpdateRaceResponse, error)
+	// DeleteRace will remove a race
+	DeleteRace(context.Context, *DeleteRaceRequest) (*DeleteRaceResponse, error)
+	// ListRunners will return the field of runners for a race
+	ListRunners(context.Context, *ListRunnersRequest) (*ListRunnersResponse, error)
+	// ListPrices will return the current prices for each runner in a race
+	ListPrices(context.Context, *ListPricesRequest) (*ListPricesResponse, error)
+	// ListPriceFluctuations will return the price history for runners in a race
+	ListPriceFluctuations(context.Context, *ListPriceFluctuationsRequest) (*ListPriceFluctuationsResponse, error)
+	// ListMeetings will return a collection of all race meetings.
+	ListMeetings(context.Context, *ListMeetingsRequest) (*ListMeetingsResponse, error)
+	// GetMeeting will return a single race meeting
+	GetMeeting(context.Context, *GetMeetingRequest) (*GetMeetingResponse, error)
+	// NextToGo will return the next races to jump
+	NextToGo(context.Context, *NextToGoRequest) (*NextToGoResponse, error)
+	// WatchRaces will stream an event whenever a race matching the filter is
+	// added, changed or changes status.
+	WatchRaces(*WatchRacesRequest, Racing_WatchRacesServer) error
+	// Search will return the races, meetings and runners best matching the text
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// BulkImport will create or update races or meetings by their external ID from a CSV or NDJSON file
+	BulkImport(context.Context, *BulkImportRequest) (*BulkImportResponse, error)
 }
 
-// UnimplementedRacingServer must be embedded to have forward compatible implementations.
+// UnimplementedRacingServer should be embedded to have forward compatible implementations.
 type UnimplementedRacingServer struct {
 }
 
@@ -80,7 +300,48 @@ func (UnimplementedRacingServer) ListRaces(context.Context, *ListRacesRequest) (
 func (UnimplementedRacingServer) GetRaceByID(context.Context, *GetRaceByIDRequest) (*GetRaceByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRaceByID not implemented")
 }
-func (UnimplementedRacingServer) mustEmbedUnimplementedRacingServer() {}
+func (UnimplementedRacingServer) TransitionRace(context.Context, *TransitionRaceRequest) (*TransitionRaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionRace not implemented")
+}
+func (UnimplementedRacingServer) GetRaceResult(context.Context, *GetRaceResultRequest) (*GetRaceResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRaceResult not implemented")
+}
+func (UnimplementedRacingServer) CreateRace(context.Context, *CreateRaceRequest) (*CreateRaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRace not implemented")
+}
+func (UnimplementedRacingServer) UpdateRace(context.Context, *UpdateRaceRequest) (*UpdateRaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRace not implemented")
+}
+func (UnimplementedRacingServer) DeleteRace(context.Context, *DeleteRaceRequest) (*DeleteRaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRace not implemented")
+}
+func (UnimplementedRacingServer) ListRunners(context.Context, *ListRunnersRequest) (*ListRunnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRunners not implemented")
+}
+func (UnimplementedRacingServer) ListPrices(context.Context, *ListPricesRequest) (*ListPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPrices not implemented")
+}
+func (UnimplementedRacingServer) ListPriceFluctuations(context.Context, *ListPriceFluctuationsRequest) (*ListPriceFluctuationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceFluctuations not implemented")
+}
+func (UnimplementedRacingServer) ListMeetings(context.Context, *ListMeetingsRequest) (*ListMeetingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMeetings not implemented")
+}
+func (UnimplementedRacingServer) GetMeeting(context.Context, *GetMeetingRequest) (*GetMeetingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeeting not implemented")
+}
+func (UnimplementedRacingServer) NextToGo(context.Context, *NextToGoRequest) (*NextToGoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextToGo not implemented")
+}
+func (UnimplementedRacingServer) WatchRaces(*WatchRacesRequest, Racing_WatchRacesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRaces not implemented")
+}
+func (UnimplementedRacingServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedRacingServer) BulkImport(context.Context, *BulkImportRequest) (*BulkImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkImport not implemented")
+}
 
 // UnsafeRacingServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RacingServer will
@@ -129,6 +390,261 @@ func _Racing_GetRaceByID_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Racing_TransitionRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionRaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).TransitionRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_TransitionRace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).TransitionRace(ctx, req.(*TransitionRaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_GetRaceResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRaceResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).GetRaceResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_GetRaceResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).GetRaceResult(ctx, req.(*GetRaceResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_CreateRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).CreateRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_CreateRace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).CreateRace(ctx, req.(*CreateRaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_UpdateRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).UpdateRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_UpdateRace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).UpdateRace(ctx, req.(*UpdateRaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_DeleteRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).DeleteRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_DeleteRace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).DeleteRace(ctx, req.(*DeleteRaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_ListRunners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).ListRunners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_ListRunners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).ListRunners(ctx, req.(*ListRunnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_ListPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).ListPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_ListPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).ListPrices(ctx, req.(*ListPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_ListPriceFluctuations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceFluctuationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).ListPriceFluctuations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_ListPriceFluctuations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).ListPriceFluctuations(ctx, req.(*ListPriceFluctuationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_ListMeetings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMeetingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).ListMeetings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_ListMeetings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).ListMeetings(ctx, req.(*ListMeetingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_GetMeeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).GetMeeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_GetMeeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).GetMeeting(ctx, req.(*GetMeetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_NextToGo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextToGoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).NextToGo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_NextToGo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).NextToGo(ctx, req.(*NextToGoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_WatchRaces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRacesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RacingServer).WatchRaces(m, &racingWatchRacesServer{stream})
+}
+
+type Racing_WatchRacesServer interface {
+	Send(*WatchRacesResponse) error
+	grpc.ServerStream
+}
+
+type racingWatchRacesServer struct {
+	grpc.ServerStream
+}
+
+func (x *racingWatchRacesServer) Send(m *WatchRacesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Racing_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_BulkImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).BulkImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Racing_BulkImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).BulkImport(ctx, req.(*BulkImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Racing_ServiceDesc is the grpc.ServiceDesc for Racing service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,7 +660,65 @@ var Racing_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRaceByID",
 			Handler:    _Racing_GetRaceByID_Handler,
 		},
+		{
+			MethodName: "TransitionRace",
+			Handler:    _Racing_TransitionRace_Handler,
+		},
+		{
+			MethodName: "GetRaceResult",
+			Handler:    _Racing_GetRaceResult_Handler,
+		},
+		{
+			MethodName: "CreateRace",
+			Handler:    _Racing_CreateRace_Handler,
+		},
+		{
+			MethodName: "UpdateRace",
+			Handler:    _Racing_UpdateRace_Handler,
+		},
+		{
+			MethodName: "DeleteRace",
+			Handler:    _Racing_DeleteRace_Handler,
+		},
+		{
+			MethodName: "ListRunners",
+			Handler:    _Racing_ListRunners_Handler,
+		},
+		{
+			MethodName: "ListPrices",
+			Handler:    _Racing_ListPrices_Handler,
+		},
+		{
+			MethodName: "ListPriceFluctuations",
+			Handler:    _Racing_ListPriceFluctuations_Handler,
+		},
+		{
+			MethodName: "ListMeetings",
+			Handler:    _Racing_ListMeetings_Handler,
+		},
+		{
+			MethodName: "GetMeeting",
+			Handler:    _Racing_GetMeeting_Handler,
+		},
+		{
+			MethodName: "NextToGo",
+			Handler:    _Racing_NextToGo_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Racing_Search_Handler,
+		},
+		{
+			MethodName: "BulkImport",
+			Handler:    _Racing_BulkImport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRaces",
+			Handler:       _Racing_WatchRaces_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "racing/racing.proto",
 }
@@ -1,563 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.1
-// 	protoc        v4.25.3
-// source: sports/sports.proto
-
-package sports
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
-	reflect "reflect"
-	sync "sync"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Request to GetSportByID
-type GetSportByIDRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *GetSportByIDRequest) Reset() {
-	*x = GetSportByIDRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sports_sports_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetSportByIDRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetSportByIDRequest) ProtoMessage() {}
-
-func (x *GetSportByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sports_sports_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetSportByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetSportByIDRequest) Descriptor() ([]byte, []int) {
-	return file_sports_sports_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *GetSportByIDRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-//Response to GetSportByID call
-type GetSportByIDResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Sport *SportEvent `protobuf:"bytes,1,opt,name=sport,proto3" json:"sport,omitempty"`
-}
-
-func (x *GetSportByIDResponse) Reset() {
-	*x = GetSportByIDResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sports_sports_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetSportByIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetSportByIDResponse) ProtoMessage() {}
-
-func (x *GetSportByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sports_sports_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetSportByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetSportByIDResponse) Descriptor() ([]byte, []int) {
-	return file_sports_sports_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *GetSportByIDResponse) GetSport() *SportEvent {
-	if x != nil {
-		return x.Sport
-	}
-	return nil
-}
-
-// Request to ListSports
-type ListSportsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Filter *ListSportsRequestFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-}
-
-func (x *ListSportsRequest) Reset() {
-	*x = ListSportsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sports_sports_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListSportsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSportsRequest) ProtoMessage() {}
-
-func (x *ListSportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sports_sports_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSportsRequest.ProtoReflect.Descriptor instead.
-func (*ListSportsRequest) Descriptor() ([]byte, []int) {
-	return file_sports_sports_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *ListSportsRequest) GetFilter() *ListSportsRequestFilter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
-}
-
-// Response to ListSports call.
-type ListSportsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Sports []*SportEvent `protobuf:"bytes,1,rep,name=sports,proto3" json:"sports,omitempty"`
-}
-
-func (x *ListSportsResponse) Reset() {
-	*x = ListSportsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sports_sports_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListSportsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSportsResponse) ProtoMessage() {}
-
-func (x *ListSportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sports_sports_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSportsResponse.ProtoReflect.Descriptor instead.
-func (*ListSportsResponse) Descriptor() ([]byte, []int) {
-	return file_sports_sports_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *ListSportsResponse) GetSports() []*SportEvent {
-	if x != nil {
-		return x.Sports
-	}
-	return nil
-}
-
-// Filter for listing sports.
-type ListSportsRequestFilter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Ids     []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	Sport   string  `protobuf:"bytes,2,opt,name=sport,proto3" json:"sport,omitempty"`
-	OrderBy string  `protobuf:"bytes,3,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
-	Sort    string  `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
-}
-
-func (x *ListSportsRequestFilter) Reset() {
-	*x = ListSportsRequestFilter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sports_sports_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListSportsRequestFilter) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSportsRequestFilter) ProtoMessage() {}
-
-func (x *ListSportsRequestFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_sports_sports_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSportsRequestFilter.ProtoReflect.Descriptor instead.
-func (*ListSportsRequestFilter) Descriptor() ([]byte, []int) {
-	return file_sports_sports_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *ListSportsRequestFilter) GetIds() []int64 {
-	if x != nil {
-		return x.Ids
-	}
-	return nil
-}
-
-func (x *ListSportsRequestFilter) GetSport() string {
-	if x != nil {
-		return x.Sport
-	}
-	return ""
-}
-
-func (x *ListSportsRequestFilter) GetOrderBy() string {
-	if x != nil {
-		return x.OrderBy
-	}
-	return ""
-}
-
-func (x *ListSportsRequestFilter) GetSort() string {
-	if x != nil {
-		return x.Sort
-	}
-	return ""
-}
-
-// A sportEvent resource.
-type SportEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// ID represents a unique identifier for the sport.
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Name is the official name given to the sport.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// AdvertisedStartTime is the time the sport is advertised to run.
-	AdvertisedStartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=advertised_start_time,json=advertisedStartTime,proto3" json:"advertised_start_time,omitempty"`
-	// Sport is the type of sport the event is played in
-	Sport string `protobuf:"bytes,4,opt,name=sport,proto3" json:"sport,omitempty"`
-	// Current score is the current score of the sport
-	CurrentScore string `protobuf:"bytes,5,opt,name=current_score,json=currentScore,proto3" json:"current_score,omitempty"`
-}
-
-func (x *SportEvent) Reset() {
-	*x = SportEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sports_sports_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SportEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SportEvent) ProtoMessage() {}
-
-func (x *SportEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_sports_sports_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SportEvent.ProtoReflect.Descriptor instead.
-func (*SportEvent) Descriptor() ([]byte, []int) {
-	return file_sports_sports_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *SportEvent) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *SportEvent) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *SportEvent) GetAdvertisedStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.AdvertisedStartTime
-	}
-	return nil
-}
-
-func (x *SportEvent) GetSport() string {
-	if x != nil {
-		return x.Sport
-	}
-	return ""
-}
-
-func (x *SportEvent) GetCurrentScore() string {
-	if x != nil {
-		return x.CurrentScore
-	}
-	return ""
-}
-
-var File_sports_sports_proto protoreflect.FileDescriptor
-
-var file_sports_sports_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x25,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72,
-	0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
-	0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x4c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x06, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52,
-	0x03, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x73, 0x70, 0x6f,
-	0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x15, 0x61,
-	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73,
-	0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72,
-	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x32, 0x9c, 0x01, 0x0a, 0x06, 0x53, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x12, 0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
-	0x19, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53,
-	0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x09, 0x5a, 0x07, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_sports_sports_proto_rawDescOnce sync.Once
-	file_sports_sports_proto_rawDescData = file_sports_sports_proto_rawDesc
-)
-
-func file_sports_sports_proto_rawDescGZIP() []byte {
-	file_sports_sports_proto_rawDescOnce.Do(func() {
-		file_sports_sports_proto_rawDescData = protoimpl.X.CompressGZIP(file_sports_sports_proto_rawDescData)
-	})
-	return file_sports_sports_proto_rawDescData
-}
-
-var file_sports_sports_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_sports_sports_proto_goTypes = []interface{}{
-	(*GetSportByIDRequest)(nil),     // 0: sports.GetSportByIDRequest
-	(*GetSportByIDResponse)(nil),    // 1: sports.GetSportByIDResponse
-	(*ListSportsRequest)(nil),       // 2: sports.ListSportsRequest
-	(*ListSportsResponse)(nil),      // 3: sports.ListSportsResponse
-	(*ListSportsRequestFilter)(nil), // 4: sports.ListSportsRequestFilter
-	(*SportEvent)(nil),              // 5: sports.sportEvent
-	(*timestamppb.Timestamp)(nil),   // 6: google.protobuf.Timestamp
-}
-var file_sports_sports_proto_depIdxs = []int32{
-	5, // 0: sports.GetSportByIDResponse.sport:type_name -> sports.sportEvent
-	4, // 1: sports.ListSportsRequest.filter:type_name -> sports.ListSportsRequestFilter
-	5, // 2: sports.ListSportsResponse.sports:type_name -> sports.sportEvent
-	6, // 3: sports.sportEvent.advertised_start_time:type_name -> google.protobuf.Timestamp
-	2, // 4: sports.Sports.ListSports:input_type -> sports.ListSportsRequest
-	0, // 5: sports.Sports.GetSportByID:input_type -> sports.GetSportByIDRequest
-	3, // 6: sports.Sports.ListSports:output_type -> sports.ListSportsResponse
-	1, // 7: sports.Sports.GetSportByID:output_type -> sports.GetSportByIDResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
-}
-
-func init() { file_sports_sports_proto_init() }
-func file_sports_sports_proto_init() {
-	if File_sports_sports_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_sports_sports_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSportByIDRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_sports_sports_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSportByIDResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_sports_sports_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSportsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_sports_sports_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSportsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_sports_sports_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSportsRequestFilter); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_sports_sports_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SportEvent); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_sports_sports_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_sports_sports_proto_goTypes,
-		DependencyIndexes: file_sports_sports_proto_depIdxs,
-		MessageInfos:      file_sports_sports_proto_msgTypes,
-	}.Build()
-	File_sports_sports_proto = out.File
-	file_sports_sports_proto_rawDesc = nil
-	file_sports_sports_proto_goTypes = nil
-	file_sports_sports_proto_depIdxs = nil
-}
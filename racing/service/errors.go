@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/db"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Maps an error from the repository onto a gRPC status so callers, and the gateway in front of
+// us, get a meaningful code. Anything unexpected is logged and reported as Internal, without the
+// detail, which can hold SQL and driver messages that aren't for clients.
+func grpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	// Already a status, e.g. a precondition checked by the service itself
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var fieldErr *db.InvalidFieldError
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		// The caller gave up or ran out of time while a query was running
+		return status.FromContextError(err).Err()
+	case errors.Is(err, db.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, db.ErrInvalidTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, db.ErrVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, db.ErrSearchUnavailable):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, db.ErrInvalidPageToken):
+		return invalidField("page_token", "not a next_page_token issued for this ordering")
+	case errors.As(err, &fieldErr):
+		return invalidField(fieldErr.Field, fieldErr.Description)
+	default:
+		log.Printf("internal error: %s\n", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+// Builds an InvalidArgument status detailing which field of the request was wrong
+func invalidField(field, description string) error {
+	st := status.New(codes.InvalidArgument, "invalid "+field+": "+description)
+
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
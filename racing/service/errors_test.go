@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCErrorFromContext(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"canceled", context.Canceled, codes.Canceled},
+		{"past its deadline", context.DeadlineExceeded, codes.DeadlineExceeded},
+		{"canceled during a query", fmt.Errorf("listing races: %w", context.Canceled), codes.Canceled},
+		{"past its deadline during a query", fmt.Errorf("listing races: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := status.Code(grpcError(test.err)); got != test.want {
+				t.Fatalf("grpcError(%v) has code %s, want %s", test.err, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package service
+
+// maxPageSize caps how many results a list returns in one page.
+const maxPageSize = 1000
+
+// Works out the page size to use for a list request. No page size, as sent by clients from
+// before there was paging, returns every result.
+func pageSize(requested int32) int32 {
+	switch {
+	case requested <= 0:
+		return 0
+	case requested > maxPageSize:
+		return maxPageSize
+	default:
+		return requested
+	}
+}
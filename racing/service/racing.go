@@ -1,44 +1,585 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/sibeyzoran/EntainGroupTest/racing/db"
 	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// watchPollInterval is how often WatchRaces checks the repository for changes made other than
+// through the service.
+const watchPollInterval = 2 * time.Second
+
+const (
+	// defaultNextToGoCount is how many races NextToGo returns when no count is given.
+	defaultNextToGoCount = 5
+	// maxNextToGoCount caps how many races NextToGo will return.
+	maxNextToGoCount = 50
+	// defaultGracePeriod is how long after jumping NextToGo keeps showing a race.
+	defaultGracePeriod = time.Minute
+)
+
+const (
+	// defaultSearchCount is how many results Search returns when no count is given.
+	defaultSearchCount = 20
+	// maxSearchCount caps how many results Search will return.
+	maxSearchCount = 100
 )
 
+// writableRaceFields are the fields of a race that can be set when creating or updating it.
+var writableRaceFields = []string{"meeting_id", "name", "number", "visible", "advertised_start_time"}
+
 type Racing interface {
 	// ListRaces will return a collection of races.
 	ListRaces(ctx context.Context, in *racing.ListRacesRequest) (*racing.ListRacesResponse, error)
 	// GetRaceByID will return a single race
 	GetRaceByID(ctx context.Context, in *racing.GetRaceByIDRequest) (*racing.GetRaceByIDResponse, error)
+	// TransitionRace will move a race to a new status
+	TransitionRace(ctx context.Context, in *racing.TransitionRaceRequest) (*racing.TransitionRaceResponse, error)
+	// CreateRace will add a new race
+	CreateRace(ctx context.Context, in *racing.CreateRaceRequest) (*racing.CreateRaceResponse, error)
+	// UpdateRace will change the fields of a race named in the update mask
+	UpdateRace(ctx context.Context, in *racing.UpdateRaceRequest) (*racing.UpdateRaceResponse, error)
+	// DeleteRace will remove a race
+	DeleteRace(ctx context.Context, in *racing.DeleteRaceRequest) (*racing.DeleteRaceResponse, error)
+	// GetRaceResult will return the result of a race
+	GetRaceResult(ctx context.Context, in *racing.GetRaceResultRequest) (*racing.GetRaceResultResponse, error)
+	// ListRunners will return the field of runners for a race
+	ListRunners(ctx context.Context, in *racing.ListRunnersRequest) (*racing.ListRunnersResponse, error)
+	// ListPrices will return the current prices for a race
+	ListPrices(ctx context.Context, in *racing.ListPricesRequest) (*racing.ListPricesResponse, error)
+	// ListPriceFluctuations will return the price history for a race
+	ListPriceFluctuations(ctx context.Context, in *racing.ListPriceFluctuationsRequest) (*racing.ListPriceFluctuationsResponse, error)
+	// ListMeetings will return a collection of meetings.
+	ListMeetings(ctx context.Context, in *racing.ListMeetingsRequest) (*racing.ListMeetingsResponse, error)
+	// GetMeeting will return a single meeting
+	GetMeeting(ctx context.Context, in *racing.GetMeetingRequest) (*racing.GetMeetingResponse, error)
+	// NextToGo will return the next races to jump
+	NextToGo(ctx context.Context, in *racing.NextToGoRequest) (*racing.NextToGoResponse, error)
+	// Search will return the races, meetings and runners best matching a search
+	Search(ctx context.Context, in *racing.SearchRequest) (*racing.SearchResponse, error)
+	// BulkImport will create or update races or meetings from a CSV or NDJSON file
+	BulkImport(ctx context.Context, in *racing.BulkImportRequest) (*racing.BulkImportResponse, error)
+	// WatchRaces will stream race events until the client goes away.
+	WatchRaces(in *racing.WatchRacesRequest, stream racing.Racing_WatchRacesServer) error
 }
 
 // racingService implements the Racing interface.
 type racingService struct {
 	racesRepo db.RacesRepo
+	watcher   *raceWatcher
 }
 
 // NewRacingService instantiates and returns a new racingService.
 func NewRacingService(racesRepo db.RacesRepo) Racing {
-	return &racingService{racesRepo}
+	return &racingService{racesRepo, newRaceWatcher(racesRepo, watchPollInterval)}
 }
 
 // List all races
 func (r *racingService) ListRaces(ctx context.Context, in *racing.ListRacesRequest) (*racing.ListRacesResponse, error) {
-	races, err := r.racesRepo.List(in.Filter)
+	races, nextPageToken, err := r.racesRepo.ListPage(ctx, in.Filter, pageSize(in.PageSize), in.PageToken)
 	if err != nil {
-		return nil, err
+		return nil, grpcError(err)
+	}
+	if err := r.attachResults(ctx, races...); err != nil {
+		return nil, grpcError(err)
 	}
 
-	return &racing.ListRacesResponse{Races: races}, nil
+	return &racing.ListRacesResponse{Races: races, NextPageToken: nextPageToken}, nil
 }
 
 // Gets and returns a single race
 func (r *racingService) GetRaceByID(ctx context.Context, in *racing.GetRaceByIDRequest) (*racing.GetRaceByIDResponse, error) {
-	race, err := r.racesRepo.GetByID(in.Id)
+	race, err := r.racesRepo.GetByID(ctx, in.Id)
 	if err != nil {
-		return nil, err
+		return nil, grpcError(err)
+	}
+	if err := r.attachResults(ctx, race); err != nil {
+		return nil, grpcError(err)
+	}
+
+	// Embed the field along with their prices if asked for
+	if in.IncludeRunners {
+		race.Runners, err = r.listRunners(ctx, race.Id)
+		if err != nil {
+			return nil, grpcError(err)
+		}
 	}
 	return &racing.GetRaceByIDResponse{Race: race}, nil
 }
+
+// Moves a race on to a new status
+func (r *racingService) TransitionRace(ctx context.Context, in *racing.TransitionRaceRequest) (*racing.TransitionRaceResponse, error) {
+	race, err := r.racesRepo.TransitionStatus(ctx, in.Id, in.Status)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	r.watcher.publish()
+
+	return &racing.TransitionRaceResponse{Race: race}, nil
+}
+
+// Adds a new race once its fields have been checked
+func (r *racingService) CreateRace(ctx context.Context, in *racing.CreateRaceRequest) (*racing.CreateRaceResponse, error) {
+	if in.Race == nil {
+		return nil, invalidField("race", "must be given")
+	}
+	if err := r.validateRace(ctx, in.Race, writableRaceFields); err != nil {
+		return nil, err
+	}
+
+	race, err := r.racesRepo.Create(ctx, in.Race)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	r.watcher.publish()
+
+	return &racing.CreateRaceResponse{Race: race}, nil
+}
+
+// Changes the fields of a race named in the update mask once they have been checked
+func (r *racingService) UpdateRace(ctx context.Context, in *racing.UpdateRaceRequest) (*racing.UpdateRaceResponse, error) {
+	if in.Race == nil {
+		return nil, invalidField("race", "must be given")
+	}
+
+	// The id and version say which race is being updated rather than being updated themselves,
+	// they turn up in the mask the gateway builds from the request body
+	var fields []string
+	for _, path := range in.UpdateMask.GetPaths() {
+		if path == "id" || path == "version" {
+			continue
+		}
+		if !contains(writableRaceFields, path) {
+			return nil, invalidField("update_mask", fmt.Sprintf("%q can't be updated, only %s can", path, strings.Join(writableRaceFields, ", ")))
+		}
+		fields = append(fields, path)
+	}
+	if len(fields) == 0 {
+		return nil, invalidField("update_mask", "must name at least one field to update")
+	}
+	if err := r.validateRace(ctx, in.Race, fields); err != nil {
+		return nil, err
+	}
+
+	race, err := r.racesRepo.Update(ctx, in.Race, fields)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	r.watcher.publish()
+	if err := r.attachResults(ctx, race); err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &racing.UpdateRaceResponse{Race: race}, nil
+}
+
+// Removes a race
+func (r *racingService) DeleteRace(ctx context.Context, in *racing.DeleteRaceRequest) (*racing.DeleteRaceResponse, error) {
+	if err := r.racesRepo.Delete(ctx, in.Id, in.Version); err != nil {
+		return nil, grpcError(err)
+	}
+	r.watcher.publish()
+
+	return &racing.DeleteRaceResponse{}, nil
+}
+
+// Checks the named fields of a race hold values it can be saved with
+func (r *racingService) validateRace(ctx context.Context, race *racing.Race, fields []string) error {
+	for _, field := range fields {
+		switch field {
+		case "meeting_id":
+			if race.MeetingId <= 0 {
+				return invalidField("race.meeting_id", "must be given")
+			}
+			if _, err := r.racesRepo.GetMeeting(ctx, race.MeetingId); errors.Is(err, db.ErrNotFound) {
+				return invalidField("race.meeting_id", fmt.Sprintf("meeting %d doesn't exist", race.MeetingId))
+			} else if err != nil {
+				return grpcError(err)
+			}
+		case "name":
+			if strings.TrimSpace(race.Name) == "" {
+				return invalidField("race.name", "must not be empty")
+			}
+		case "number":
+			if race.Number <= 0 {
+				return invalidField("race.number", "must be a positive number")
+			}
+		case "advertised_start_time":
+			if race.AdvertisedStartTime == nil {
+				return invalidField("race.advertised_start_time", "must be given")
+			}
+			if err := race.AdvertisedStartTime.CheckValid(); err != nil {
+				return invalidField("race.advertised_start_time", err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// Reports whether a list of fields includes the one given
+func contains(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Gets the result of a race once it has reached INTERIM or FINAL
+func (r *racingService) GetRaceResult(ctx context.Context, in *racing.GetRaceResultRequest) (*racing.GetRaceResultResponse, error) {
+	race, err := r.racesRepo.GetByID(ctx, in.RaceId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if !isResulted(race) {
+		return nil, status.Errorf(codes.FailedPrecondition, "race %d has not been resulted, it is %s", race.Id, race.Status)
+	}
+
+	if err := r.attachResults(ctx, race); err != nil {
+		return nil, grpcError(err)
+	}
+	// A race can be moved on to INTERIM or FINAL before its result is recorded
+	if race.Result == nil {
+		return nil, status.Errorf(codes.NotFound, "race %d is %s, but its result hasn't been recorded", race.Id, race.Status)
+	}
+
+	return &racing.GetRaceResultResponse{Result: race.Result}, nil
+}
+
+// Fills in the result of each race that has reached INTERIM or FINAL
+func (r *racingService) attachResults(ctx context.Context, races ...*racing.Race) error {
+	var raceIDs []int64
+	for _, race := range races {
+		if isResulted(race) {
+			raceIDs = append(raceIDs, race.Id)
+		}
+	}
+
+	results, err := r.racesRepo.ListResults(ctx, raceIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, race := range races {
+		if result, ok := results[race.Id]; ok {
+			result.Status = race.Status
+			race.Result = result
+		}
+	}
+
+	return nil
+}
+
+// Reports whether a race has reached a stage where it has a result
+func isResulted(race *racing.Race) bool {
+	return race.Status == racing.Race_INTERIM || race.Status == racing.Race_FINAL
+}
+
+// Lists the runners in a race
+func (r *racingService) ListRunners(ctx context.Context, in *racing.ListRunnersRequest) (*racing.ListRunnersResponse, error) {
+	if _, err := r.racesRepo.GetByID(ctx, in.RaceId); err != nil {
+		return nil, grpcError(err)
+	}
+
+	runners, err := r.listRunners(ctx, in.RaceId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &racing.ListRunnersResponse{Runners: runners}, nil
+}
+
+// Lists the runners in a race with their current prices filled in
+func (r *racingService) listRunners(ctx context.Context, raceID int64) ([]*racing.Runner, error) {
+	runners, err := r.racesRepo.ListRunners(ctx, raceID)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := r.racesRepo.ListPrices(ctx, raceID)
+	if err != nil {
+		return nil, err
+	}
+
+	byRunner := make(map[int64]*racing.Price, len(prices))
+	for _, price := range prices {
+		byRunner[price.RunnerId] = price
+	}
+	for _, runner := range runners {
+		runner.Price = byRunner[runner.Id]
+	}
+
+	return runners, nil
+}
+
+// Lists the current prices for a race
+func (r *racingService) ListPrices(ctx context.Context, in *racing.ListPricesRequest) (*racing.ListPricesResponse, error) {
+	if _, err := r.racesRepo.GetByID(ctx, in.RaceId); err != nil {
+		return nil, grpcError(err)
+	}
+
+	prices, err := r.racesRepo.ListPrices(ctx, in.RaceId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &racing.ListPricesResponse{Prices: prices}, nil
+}
+
+// Lists how the prices for a race, or a single runner in it, have moved
+func (r *racingService) ListPriceFluctuations(ctx context.Context, in *racing.ListPriceFluctuationsRequest) (*racing.ListPriceFluctuationsResponse, error) {
+	if _, err := r.racesRepo.GetByID(ctx, in.RaceId); err != nil {
+		return nil, grpcError(err)
+	}
+
+	fluctuations, err := r.racesRepo.ListPriceHistory(ctx, in.RaceId, in.RunnerId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &racing.ListPriceFluctuationsResponse{Fluctuations: fluctuations}, nil
+}
+
+// List all meetings
+func (r *racingService) ListMeetings(ctx context.Context, in *racing.ListMeetingsRequest) (*racing.ListMeetingsResponse, error) {
+	meetings, err := r.racesRepo.ListMeetings(ctx, in.Filter)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &racing.ListMeetingsResponse{Meetings: meetings}, nil
+}
+
+// Gets and returns a single meeting
+func (r *racingService) GetMeeting(ctx context.Context, in *racing.GetMeetingRequest) (*racing.GetMeetingResponse, error) {
+	meeting, err := r.racesRepo.GetMeeting(ctx, in.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &racing.GetMeetingResponse{Meeting: meeting}, nil
+}
+
+// Lists the next visible races to jump, soonest first
+func (r *racingService) NextToGo(ctx context.Context, in *racing.NextToGoRequest) (*racing.NextToGoResponse, error) {
+	count := int(in.Count)
+	if count <= 0 {
+		count = defaultNextToGoCount
+	}
+	if count > maxNextToGoCount {
+		count = maxNextToGoCount
+	}
+	gracePeriod := defaultGracePeriod
+	if in.GracePeriodSeconds != nil {
+		gracePeriod = time.Duration(in.GetGracePeriodSeconds()) * time.Second
+	}
+
+	races, err := r.racesRepo.List(ctx, &racing.ListRacesRequestFilter{
+		VisibleOnly:       true,
+		MeetingCategories: in.Categories,
+		OrderBy:           "advertised_start_time",
+		Sort:              "asc",
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	// Skip races that jumped longer ago than the grace period, or won't be run
+	cutoff := time.Now().Add(-gracePeriod)
+	var candidates []*racing.Race
+	for _, race := range races {
+		if race.AdvertisedStartTime.AsTime().Before(cutoff) {
+			continue
+		}
+		if race.Status == racing.Race_ABANDONED || race.Status == racing.Race_POSTPONED {
+			continue
+		}
+		candidates = append(candidates, race)
+	}
+
+	if !in.SpreadCategories {
+		if len(candidates) > count {
+			candidates = candidates[:count]
+		}
+		return &racing.NextToGoResponse{Races: candidates}, nil
+	}
+
+	categories, err := r.meetingCategories(ctx, candidates)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &racing.NextToGoResponse{Races: spreadByCategory(candidates, categories, count)}, nil
+}
+
+// Looks up the category of the meeting each race belongs to, keyed by meeting ID
+func (r *racingService) meetingCategories(ctx context.Context, races []*racing.Race) (map[int64]racing.Meeting_Category, error) {
+	var meetingIDs []int64
+	for _, race := range races {
+		meetingIDs = append(meetingIDs, race.MeetingId)
+	}
+	if len(meetingIDs) == 0 {
+		return nil, nil
+	}
+
+	meetings, err := r.racesRepo.ListMeetings(ctx, &racing.ListMeetingsRequestFilter{Ids: meetingIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make(map[int64]racing.Meeting_Category, len(meetings))
+	for _, meeting := range meetings {
+		categories[meeting.Id] = meeting.Category
+	}
+
+	return categories, nil
+}
+
+// Picks up to count races taking the soonest race from each category in turn, so one
+// category can't crowd out the others. The races picked are returned soonest first.
+func spreadByCategory(races []*racing.Race, categories map[int64]racing.Meeting_Category, count int) []*racing.Race {
+	var picked []*racing.Race
+	taken := make(map[int64]bool)
+
+	for len(picked) < count {
+		round := make(map[racing.Meeting_Category]bool)
+		for _, race := range races {
+			if len(picked) == count {
+				break
+			}
+
+			category := categories[race.MeetingId]
+			if taken[race.Id] || round[category] {
+				continue
+			}
+			round[category] = true
+			taken[race.Id] = true
+			picked = append(picked, race)
+		}
+
+		// Every race has been picked
+		if len(round) == 0 {
+			break
+		}
+	}
+
+	sort.SliceStable(picked, func(i, j int) bool {
+		return picked[i].AdvertisedStartTime.AsTime().Before(picked[j].AdvertisedStartTime.AsTime())
+	})
+
+	return picked
+}
+
+// Searches the names of races, meetings and runners, best match first
+func (r *racingService) Search(ctx context.Context, in *racing.SearchRequest) (*racing.SearchResponse, error) {
+	if strings.TrimSpace(in.Q) == "" {
+		return nil, invalidField("q", "must be given")
+	}
+	count := int(in.Count)
+	if count <= 0 {
+		count = defaultSearchCount
+	}
+	if count > maxSearchCount {
+		count = maxSearchCount
+	}
+
+	results, err := r.racesRepo.Search(ctx, in.Q, count)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &racing.SearchResponse{Results: results}, nil
+}
+
+// Imports races or meetings by their external ID, reporting the rows that couldn't be imported
+func (r *racingService) BulkImport(ctx context.Context, in *racing.BulkImportRequest) (*racing.BulkImportResponse, error) {
+	response, err := r.racesRepo.BulkImport(ctx, in.Kind, in.Format, in.Data, in.DryRun)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if !response.DryRun && response.Created+response.Updated > 0 {
+		r.watcher.publish()
+	}
+
+	return response, nil
+}
+
+// Streams an event for every race that is added, changed or removed from the filtered list. The
+// list is only checked again once the watcher has seen the races change.
+func (r *racingService) WatchRaces(in *racing.WatchRacesRequest, stream racing.Racing_WatchRacesServer) error {
+	ctx := stream.Context()
+	changes := r.watcher.subscribe()
+	defer r.watcher.unsubscribe(changes)
+
+	// Races already sent to the client, keyed by ID
+	known := make(map[int64]*racing.Race)
+
+	for {
+		races, err := r.racesRepo.List(ctx, in.Filter)
+		if err != nil {
+			return grpcError(err)
+		}
+		if err := r.attachResults(ctx, races...); err != nil {
+			return grpcError(err)
+		}
+
+		matched := make(map[int64]bool, len(races))
+		for _, race := range races {
+			matched[race.Id] = true
+
+			eventType := raceEventType(known[race.Id], race)
+			if eventType == racing.WatchRacesResponse_EVENT_TYPE_UNSPECIFIED {
+				continue
+			}
+			if err := stream.Send(&racing.WatchRacesResponse{Type: eventType, Race: race}); err != nil {
+				return err
+			}
+			known[race.Id] = race
+		}
+
+		// Anything we sent previously that is no longer in the list has been removed
+		for id, race := range known {
+			if matched[id] {
+				continue
+			}
+			if err := stream.Send(&racing.WatchRacesResponse{Type: racing.WatchRacesResponse_REMOVED, Race: race}); err != nil {
+				return err
+			}
+			delete(known, id)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+		}
+	}
+}
+
+// Works out which event, if any, should be sent for a race compared to its last known state
+func raceEventType(previous, current *racing.Race) racing.WatchRacesResponse_EventType {
+	switch {
+	case previous == nil:
+		return racing.WatchRacesResponse_ADDED
+	case previous.Status != current.Status:
+		return racing.WatchRacesResponse_STATUS_CHANGED
+	case !proto.Equal(previous, current):
+		return racing.WatchRacesResponse_CHANGED
+	default:
+		return racing.WatchRacesResponse_EVENT_TYPE_UNSPECIFIED
+	}
+}
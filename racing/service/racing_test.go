@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/db"
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// A races repository whose list runs until its context is done, as a slow query would
+type slowRacesRepo struct {
+	db.RacesRepo
+}
+
+func (slowRacesRepo) ListPage(ctx context.Context, filter *racing.ListRacesRequestFilter, pageSize int32, pageToken string) ([]*racing.Race, string, error) {
+	<-ctx.Done()
+	return nil, "", ctx.Err()
+}
+
+func TestListRacesStopsWithTheContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		cancel  bool
+		want    codes.Code
+	}{
+		{name: "canceled", timeout: time.Minute, cancel: true, want: codes.Canceled},
+		{name: "past its deadline", timeout: 10 * time.Millisecond, want: codes.DeadlineExceeded},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+			defer cancel()
+			if test.cancel {
+				time.AfterFunc(10*time.Millisecond, cancel)
+			}
+
+			_, err := NewRacingService(slowRacesRepo{}).ListRaces(ctx, &racing.ListRacesRequest{})
+			if got := status.Code(err); got != test.want {
+				t.Fatalf("ListRaces() returned error %v, want code %s", err, test.want)
+			}
+		})
+	}
+}
+
+// A races repository holding a single race, which has no result recorded
+type unresultedRacesRepo struct {
+	db.RacesRepo
+	race *racing.Race
+}
+
+func (r unresultedRacesRepo) GetByID(ctx context.Context, id int64) (*racing.Race, error) {
+	return r.race, nil
+}
+
+func (unresultedRacesRepo) ListResults(ctx context.Context, raceIDs []int64) (map[int64]*racing.RaceResult, error) {
+	return map[int64]*racing.RaceResult{}, nil
+}
+
+func TestGetRaceResultWithoutAResult(t *testing.T) {
+	tests := []struct {
+		status racing.Race_Status
+		want   codes.Code
+	}{
+		{racing.Race_CLOSED, codes.FailedPrecondition},
+		{racing.Race_INTERIM, codes.NotFound},
+		{racing.Race_FINAL, codes.NotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.status.String(), func(t *testing.T) {
+			service := NewRacingService(unresultedRacesRepo{race: &racing.Race{Id: 1, Status: test.status}})
+
+			response, err := service.GetRaceResult(context.Background(), &racing.GetRaceResultRequest{RaceId: 1})
+			if got := status.Code(err); got != test.want {
+				t.Fatalf("GetRaceResult() returned %v and error %v, want code %s", response, err, test.want)
+			}
+		})
+	}
+}
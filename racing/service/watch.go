@@ -0,0 +1,118 @@
+package service
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/db"
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// raceWatcher tells every WatchRaces stream when the races may have changed, so each only lists
+// its races again when there is something to find. Writes made through the service are passed on
+// as they happen. Anything else, e.g. a race whose status follows the clock or a race imported
+// from the command line, is caught by a single poller shared by every stream.
+type raceWatcher struct {
+	racesRepo db.RacesRepo
+	interval  time.Duration
+
+	mu sync.Mutex
+	// subscribers are the streams watching, each told of a change by a send on its channel
+	subscribers map[chan struct{}]bool
+	// stopPolling stops the poller, which only runs while there are subscribers
+	stopPolling context.CancelFunc
+}
+
+func newRaceWatcher(racesRepo db.RacesRepo, interval time.Duration) *raceWatcher {
+	return &raceWatcher{racesRepo: racesRepo, interval: interval, subscribers: make(map[chan struct{}]bool)}
+}
+
+// Subscribes a stream to changes, starting the poller for the first one
+func (w *raceWatcher) subscribe() chan struct{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	changes := make(chan struct{}, 1)
+	w.subscribers[changes] = true
+	if w.stopPolling == nil {
+		var ctx context.Context
+		ctx, w.stopPolling = context.WithCancel(context.Background())
+		go w.poll(ctx)
+	}
+
+	return changes
+}
+
+// Unsubscribes a stream from changes, stopping the poller after the last one
+func (w *raceWatcher) unsubscribe(changes chan struct{}) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.subscribers, changes)
+	if len(w.subscribers) == 0 && w.stopPolling != nil {
+		w.stopPolling()
+		w.stopPolling = nil
+	}
+}
+
+// Tells every subscriber the races may have changed. A subscriber that hasn't caught up with the
+// last change yet isn't told again, as it lists its races afresh anyway.
+func (w *raceWatcher) publish() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for changes := range w.subscribers {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Lists every race each interval, publishing a change whenever any race differs from the last list
+func (w *raceWatcher) poll(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	var previous map[int64]*racing.Race
+	for {
+		races, err := w.racesRepo.List(ctx, nil)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("watching races: %s\n", err)
+		}
+		if err == nil {
+			current := make(map[int64]*racing.Race, len(races))
+			for _, race := range races {
+				current[race.Id] = race
+			}
+			if previous != nil && racesChanged(previous, current) {
+				w.publish()
+			}
+			previous = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reports whether any race has been added, changed or removed between two lists keyed by ID
+func racesChanged(previous, current map[int64]*racing.Race) bool {
+	if len(previous) != len(current) {
+		return true
+	}
+	for id, race := range current {
+		if !proto.Equal(previous[id], race) {
+			return true
+		}
+	}
+
+	return false
+}
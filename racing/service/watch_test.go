@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/sibeyzoran/EntainGroupTest/racing/db"
+	"github.com/sibeyzoran/EntainGroupTest/racing/proto/racing"
+)
+
+// A races repository held in memory, counting how often the races are listed
+type memoryRacesRepo struct {
+	db.RacesRepo
+
+	mu    sync.Mutex
+	races []*racing.Race
+	lists int
+}
+
+func (r *memoryRacesRepo) List(ctx context.Context, filter *racing.ListRacesRequestFilter) ([]*racing.Race, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lists++
+	races := make([]*racing.Race, len(r.races))
+	for i, race := range r.races {
+		races[i] = proto.Clone(race).(*racing.Race)
+	}
+
+	return races, nil
+}
+
+func (r *memoryRacesRepo) Create(ctx context.Context, race *racing.Race) (*racing.Race, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	created := proto.Clone(race).(*racing.Race)
+	created.Id = int64(len(r.races) + 1)
+	r.races = append(r.races, created)
+
+	return created, nil
+}
+
+func (r *memoryRacesRepo) GetMeeting(ctx context.Context, id int64) (*racing.Meeting, error) {
+	return &racing.Meeting{Id: id}, nil
+}
+
+func (r *memoryRacesRepo) ListResults(ctx context.Context, raceIDs []int64) (map[int64]*racing.RaceResult, error) {
+	return map[int64]*racing.RaceResult{}, nil
+}
+
+// Changes the status of a race behind the service's back, as the clock or another process could
+func (r *memoryRacesRepo) setStatus(id int64, status racing.Race_Status) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.races[id-1].Status = status
+}
+
+// Counts the lists since the last time they were counted
+func (r *memoryRacesRepo) countLists() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lists := r.lists
+	r.lists = 0
+	return lists
+}
+
+// A WatchRaces stream passing on the events sent to it
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *racing.WatchRacesResponse
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(event *racing.WatchRacesResponse) error {
+	s.events <- event
+	return nil
+}
+
+// Starts watching every race, until the end of the test
+func startWatching(t *testing.T, service *racingService) *watchStream {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &watchStream{ctx: ctx, events: make(chan *racing.WatchRacesResponse, 10)}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := service.WatchRaces(&racing.WatchRacesRequest{}, stream); err != nil {
+			t.Errorf("WatchRaces() returned error %v", err)
+		}
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	return stream
+}
+
+// Waits for the next event on a stream, failing the test if it doesn't come or isn't the one wanted
+func expectEvent(t *testing.T, stream *watchStream, eventType racing.WatchRacesResponse_EventType, raceID int64) {
+	t.Helper()
+
+	select {
+	case event := <-stream.events:
+		if event.Type != eventType || event.Race.GetId() != raceID {
+			t.Fatalf("WatchRaces() sent %s for race %d, want %s for race %d", event.Type, event.Race.GetId(), eventType, raceID)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("WatchRaces() sent nothing, want %s for race %d", eventType, raceID)
+	}
+}
+
+func TestWatchRacesSendsWritesAsTheyHappen(t *testing.T) {
+	repo := &memoryRacesRepo{races: []*racing.Race{{Id: 1, Name: "First"}}}
+	// The poller would take far longer than the test to see the race created
+	service := &racingService{repo, newRaceWatcher(repo, time.Hour)}
+
+	stream := startWatching(t, service)
+	expectEvent(t, stream, racing.WatchRacesResponse_ADDED, 1)
+
+	_, err := service.CreateRace(context.Background(), &racing.CreateRaceRequest{Race: &racing.Race{
+		MeetingId:           1,
+		Name:                "Second",
+		Number:              2,
+		AdvertisedStartTime: &timestamppb.Timestamp{Seconds: time.Now().Unix()},
+	}})
+	if err != nil {
+		t.Fatalf("CreateRace() returned error %v", err)
+	}
+	expectEvent(t, stream, racing.WatchRacesResponse_ADDED, 2)
+}
+
+func TestWatchRacesSharesOnePoller(t *testing.T) {
+	repo := &memoryRacesRepo{races: []*racing.Race{{Id: 1, Name: "First", Status: racing.Race_OPEN}}}
+	service := &racingService{repo, newRaceWatcher(repo, 10*time.Millisecond)}
+
+	var streams []*watchStream
+	for i := 0; i < 5; i++ {
+		stream := startWatching(t, service)
+		expectEvent(t, stream, racing.WatchRacesResponse_ADDED, 1)
+		streams = append(streams, stream)
+	}
+
+	// Polling for each stream would list the races five times every interval, rather than once
+	repo.countLists()
+	time.Sleep(100 * time.Millisecond)
+	if lists := repo.countLists(); lists > 15 {
+		t.Errorf("listed the races %d times in 10 intervals while nothing changed, want about 10", lists)
+	}
+
+	// A change made other than through the service is still sent to every stream
+	repo.setStatus(1, racing.Race_CLOSED)
+	for _, stream := range streams {
+		expectEvent(t, stream, racing.WatchRacesResponse_STATUS_CHANGED, 1)
+	}
+}
@@ -0,0 +1,182 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+)
+
+// Compiles the list of competitions and applies filters if present
+func (s *sportsRepo) ListCompetitions(ctx context.Context, filter *sports.ListCompetitionsRequestFilter) ([]*sports.Competition, error) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	query := getCompetitionQueries()[competitionsList]
+
+	if filter != nil {
+		// Filters via competition ID - int array
+		if len(filter.Ids) > 0 {
+			clauses = append(clauses, "competitions.id IN ("+strings.Repeat("?,", len(filter.Ids)-1)+"?)")
+
+			for _, id := range filter.Ids {
+				args = append(args, id)
+			}
+		}
+
+		// Filters via sport
+		if filter.Sport != "" {
+			sport, err := s.sportSlug(ctx, "filter.sport", filter.Sport)
+			if err != nil {
+				return nil, err
+			}
+			clauses = append(clauses, "competitions.sport = ?")
+			args = append(args, sport)
+		}
+	}
+
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+	query += " ORDER BY competitions.id"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var competitions []*sports.Competition
+	for rows.Next() {
+		var competition sports.Competition
+		if err := rows.Scan(&competition.Id, &competition.Name, &competition.Sport); err != nil {
+			return nil, err
+		}
+
+		competitions = append(competitions, &competition)
+	}
+
+	return competitions, rows.Err()
+}
+
+// Compiles the list of teams and applies filters if present
+func (s *sportsRepo) ListTeams(ctx context.Context, filter *sports.ListTeamsRequestFilter) ([]*sports.Team, error) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	query := getTeamQueries()[teamsList]
+
+	if filter != nil {
+		// Filters via team ID - int array
+		if len(filter.Ids) > 0 {
+			clauses = append(clauses, "teams.id IN ("+strings.Repeat("?,", len(filter.Ids)-1)+"?)")
+
+			for _, id := range filter.Ids {
+				args = append(args, id)
+			}
+		}
+
+		// Filters via competition ID - int array
+		if len(filter.CompetitionIds) > 0 {
+			clauses = append(clauses, "teams.competition_id IN ("+strings.Repeat("?,", len(filter.CompetitionIds)-1)+"?)")
+
+			for _, id := range filter.CompetitionIds {
+				args = append(args, id)
+			}
+		}
+
+		// Filters via the sport of the competition the team plays in
+		if filter.Sport != "" {
+			sport, err := s.sportSlug(ctx, "filter.sport", filter.Sport)
+			if err != nil {
+				return nil, err
+			}
+			clauses = append(clauses, "teams.competition_id IN (SELECT competitions.id FROM competitions WHERE competitions.sport = ?)")
+			args = append(args, sport)
+		}
+	}
+
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+	query += " ORDER BY teams.competition_id, teams.name, teams.id"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []*sports.Team
+	for rows.Next() {
+		var team sports.Team
+		if err := rows.Scan(&team.Id, &team.CompetitionId, &team.Name); err != nil {
+			return nil, err
+		}
+
+		teams = append(teams, &team)
+	}
+
+	return teams, rows.Err()
+}
+
+// Checks the competition and teams of a sport event exist, that the teams play in the competition
+// and that the competition is for the sport being played
+func (s *sportsRepo) checkParticipants(ctx context.Context, sport *sports.SportEvent) error {
+	sportName, err := s.sportSlug(ctx, "sport.sport", sport.Sport)
+	if err != nil {
+		return err
+	}
+
+	if sport.CompetitionId == 0 {
+		if sport.HomeTeamId != 0 || sport.AwayTeamId != 0 {
+			return &InvalidFieldError{Field: "sport.competition_id", Description: "must be given along with teams"}
+		}
+		return nil
+	}
+
+	var competitionSport string
+	err = s.db.QueryRowContext(ctx, "SELECT sport FROM competitions WHERE id = ?", sport.CompetitionId).Scan(&competitionSport)
+	if err == sql.ErrNoRows {
+		return &InvalidFieldError{Field: "sport.competition_id", Description: fmt.Sprintf("competition %d doesn't exist", sport.CompetitionId)}
+	}
+	if err != nil {
+		return err
+	}
+	if sportName != competitionSport {
+		return &InvalidFieldError{Field: "sport.competition_id", Description: fmt.Sprintf("competition %d is for %s, not %s", sport.CompetitionId, competitionSport, sport.Sport)}
+	}
+
+	if sport.HomeTeamId != 0 && sport.HomeTeamId == sport.AwayTeamId {
+		return &InvalidFieldError{Field: "sport.away_team_id", Description: "must be a different team to home_team_id"}
+	}
+
+	for _, team := range []struct {
+		field string
+		id    int64
+	}{{"sport.home_team_id", sport.HomeTeamId}, {"sport.away_team_id", sport.AwayTeamId}} {
+		if team.id == 0 {
+			continue
+		}
+
+		var competitionID int64
+		err := s.db.QueryRowContext(ctx, "SELECT competition_id FROM teams WHERE id = ?", team.id).Scan(&competitionID)
+		if err == sql.ErrNoRows {
+			return &InvalidFieldError{Field: team.field, Description: fmt.Sprintf("team %d doesn't exist", team.id)}
+		}
+		if err != nil {
+			return err
+		}
+		if competitionID != sport.CompetitionId {
+			return &InvalidFieldError{Field: team.field, Description: fmt.Sprintf("team %d doesn't play in competition %d", team.id, sport.CompetitionId)}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,458 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"syreclabs.com/go/faker"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+)
+
+// How the sport events of a sport in the catalogue are seeded. Teams are seeded for the competition
+// of each sport, markets are priced around the typical winning margin and total points of the sport,
+// and only offer a draw in the head to head when draws are common.
+type sportTypeData struct {
+	slug    string
+	periods int
+	teams   int
+	draws   bool
+	margin  float64
+	total   float64
+}
+
+// The sports seeded, in the order of the catalogue the migrations create
+var sportTypesData = []sportTypeData{
+	{"afl", 4, 18, false, 40, 165},
+	{"basketball", 4, 30, false, 12, 220},
+	{"hockey", 3, 32, false, 2, 5.5},
+	{"rugby-league", 2, 17, false, 16, 42},
+	{"soccer", 2, 20, true, 1.5, 2.5},
+}
+
+// SeedOptions controls the dummy data sport events are seeded with. The same options always make up
+// the same sport events, so an empty database, or any database seeded with Reset, ends up with the same data.
+type SeedOptions struct {
+	// Seed is where the random numbers the data is made up from start
+	Seed int64
+	// SportEvents is how many sport events are seeded
+	SportEvents int
+	// Sports weighs how often each sport is picked for a sport event, keyed by slug. Sports left
+	// out aren't seeded, and every sport is picked as often as the others when it is empty.
+	Sports map[string]int
+	// Now is the time sport events are seeded around, each starting between From and To after it.
+	// Sport events that have started by Now are seeded as under way or finished, with scores.
+	Now      time.Time
+	From, To time.Duration
+	// Reset deletes everything stored before seeding, rather than only filling in what's missing
+	Reset bool
+}
+
+// DefaultSeedOptions returns the options for seeding 100 sport events evenly across the sports,
+// starting from a day ago up to two days from now.
+func DefaultSeedOptions() SeedOptions {
+	return SeedOptions{
+		Seed:        time.Now().UnixNano(),
+		SportEvents: 100,
+		Now:         time.Now().Truncate(time.Second),
+		From:        -24 * time.Hour,
+		To:          48 * time.Hour,
+	}
+}
+
+// Checks the options can seed sport events
+func (o SeedOptions) validate() error {
+	switch {
+	case o.SportEvents < 0:
+		return fmt.Errorf("invalid seed options: %d sport events, it can't be negative", o.SportEvents)
+	case o.From >= o.To:
+		return fmt.Errorf("invalid seed options: sport events start from %s up to %s, the earliest has to come before the latest", o.From, o.To)
+	}
+
+	var total int
+	for sport, weight := range o.Sports {
+		if seedSportType(sport) == nil {
+			return fmt.Errorf("invalid seed options: %q is not a sport, use one of %s", sport, seedSportSlugs())
+		}
+		if weight < 0 {
+			return fmt.Errorf("invalid seed options: %s is weighted %d, it can't be negative", sport, weight)
+		}
+		total += weight
+	}
+	if len(o.Sports) > 0 && total == 0 {
+		return fmt.Errorf("invalid seed options: every sport is weighted 0")
+	}
+
+	return nil
+}
+
+// The tables seeded, in the order they are reset. The catalogue of sports and their competitions
+// come from the migrations, so they are kept.
+var seedTables = []string{"sports", "score_updates", "score_periods", "markets", "selections", "teams"}
+
+func (s *sportsRepo) seed() error {
+	// Every random number the data is made up from follows on from the seed
+	s.random = rand.New(rand.NewSource(s.seeding.Seed))
+	faker.Seed(s.seeding.Seed)
+
+	// Start over from empty tables, which the same options always fill the same way
+	if s.seeding.Reset {
+		if err := s.resetSeed(); err != nil {
+			return err
+		}
+	}
+
+	// Sport events are played between teams of the same competition
+	competitions, err := s.ListCompetitions(context.Background(), nil)
+	if err == nil {
+		err = s.seedTeams(competitions)
+	}
+	var teams map[int64][]*sports.Team
+	if err == nil {
+		teams, err = s.teamsByCompetition()
+	}
+	if err != nil {
+		return err
+	}
+
+	statement, err := s.db.Prepare(`INSERT OR IGNORE INTO sports(id, name, advertised_start_time, sport, current_score, home_competitor, away_competitor, home_points, away_points, status, competition_id, home_team_id, away_team_id) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)`)
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	// Insert fake data into the table
+	for i := 1; i <= s.seeding.SportEvents; i++ {
+		// Select a random sport from the mix
+		sportType := s.seedSportPick()
+		sport := sportType.slug
+
+		// Make a random team match up from the competition for the sport
+		competitionID := seedCompetitionID(competitions, sport)
+		competitionTeams := teams[competitionID]
+		if len(competitionTeams) < 2 {
+			continue
+		}
+		home := s.random.Intn(len(competitionTeams))
+		away := (home + 1 + s.random.Intn(len(competitionTeams)-1)) % len(competitionTeams)
+		teamA := competitionTeams[home]
+		teamB := competitionTeams[away]
+		name := fmt.Sprintf("%s VS %s", teamA.Name, teamB.Name)
+
+		// Pick where the sport event is up to from when it starts
+		advertisedStart := s.seedTime(s.seeding.From, s.seeding.To)
+		status := s.seedSportStatus(advertisedStart)
+
+		// Make a random score, period by period, for the periods played so far
+		periods := make([][2]int, s.seedPeriodsPlayed(sportType.periods, status))
+		var homePoints, awayPoints int
+		for p := range periods {
+			periods[p] = [2]int{s.random.Intn(151 / sportType.periods), s.random.Intn(151 / sportType.periods)}
+			homePoints += periods[p][0]
+			awayPoints += periods[p][1]
+		}
+		currentScore := fmt.Sprintf("%d-%d", homePoints, awayPoints)
+
+		result, err := statement.Exec(
+			i,
+			name,
+			advertisedStart.Format(time.RFC3339),
+			sport,
+			currentScore,
+			teamA.Name,
+			teamB.Name,
+			homePoints,
+			awayPoints,
+			status.String(),
+			competitionID,
+			teamA.Id,
+			teamB.Id,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Only break down the score of sport events that were just added, the rest already have theirs
+		added, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		for p := 0; added > 0 && p < len(periods); p++ {
+			_, err = s.db.Exec(
+				`INSERT OR IGNORE INTO score_periods(sport_event_id, period, home_points, away_points) VALUES (?,?,?,?)`,
+				i,
+				p+1,
+				periods[p][0],
+				periods[p][1],
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.seedMarkets()
+}
+
+// Seeds the teams that play in each competition
+func (s *sportsRepo) seedTeams(competitions []*sports.Competition) error {
+	var err error
+
+	teamID := 1
+	for _, competition := range competitions {
+		var teams int
+		if sportType := seedSportType(competition.Sport); sportType != nil {
+			teams = sportType.teams
+		}
+
+		for t := 0; t < teams; t++ {
+			if err == nil {
+				_, err = s.db.Exec(`INSERT OR IGNORE INTO teams(id, competition_id, name) VALUES (?,?,?)`, teamID, competition.Id, faker.Team().Name())
+			}
+			teamID++
+		}
+	}
+
+	return err
+}
+
+// Seeds head to head, line and total points markets for each seeded sport event that doesn't have any
+func (s *sportsRepo) seedMarkets() error {
+	sportEvents, err := s.ListSports(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, sport := range sportEvents {
+		if sport.Id > int64(s.seeding.SportEvents) {
+			continue
+		}
+
+		var priced bool
+		err = tx.QueryRow(`SELECT EXISTS (SELECT 1 FROM markets WHERE sport_event_id = ?)`, sport.Id).Scan(&priced)
+		if err != nil {
+			return err
+		}
+		if priced {
+			continue
+		}
+
+		for _, market := range s.seedSportMarkets(sport) {
+			result, err := tx.Exec(
+				`INSERT INTO markets(sport_event_id, type, name, line, is_primary) VALUES (?,?,?,?,?)`,
+				sport.Id,
+				market.Type.String(),
+				market.Name,
+				market.Line,
+				market.Primary,
+			)
+			if err != nil {
+				return err
+			}
+
+			marketID, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+
+			for _, selection := range market.Selections {
+				_, err = tx.Exec(`INSERT INTO selections(market_id, name, price) VALUES (?,?,?)`, marketID, selection.Name, selection.Price)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Prices up the markets for a sport event from a random chance of the home team winning
+func (s *sportsRepo) seedSportMarkets(sport *sports.SportEvent) []*sports.Market {
+	var sportType = sportTypesData[0]
+	if data := seedSportType(sport.Sport); data != nil {
+		sportType = *data
+	}
+
+	home, away := sport.GetScore().GetHomeCompetitor(), sport.GetScore().GetAwayCompetitor()
+	if home == "" || away == "" {
+		home, away = "Home", "Away"
+	}
+
+	homeChance := 0.2 + s.random.Float64()*0.55
+	headToHead := &sports.Market{Type: sports.Market_HEAD_TO_HEAD, Name: "Head to Head", Primary: true}
+	if sportType.draws {
+		drawChance := 0.2 + s.random.Float64()*0.1
+		headToHead.Selections = []*sports.Selection{
+			{Name: home, Price: seedPrice(homeChance * (1 - drawChance))},
+			{Name: "Draw", Price: seedPrice(drawChance)},
+			{Name: away, Price: seedPrice((1 - homeChance) * (1 - drawChance))},
+		}
+	} else {
+		headToHead.Selections = []*sports.Selection{
+			{Name: home, Price: seedPrice(homeChance)},
+			{Name: away, Price: seedPrice(1 - homeChance)},
+		}
+	}
+
+	// The favourite gives away a start in proportion to how strongly they are favoured, lines and
+	// totals always end in .5 so they can't be drawn
+	line := math.Floor((0.5-homeChance)*2*sportType.margin) + 0.5
+	total := math.Floor(sportType.total*(0.9+s.random.Float64()*0.2)) + 0.5
+
+	return []*sports.Market{
+		headToHead,
+		{
+			Type: sports.Market_LINE,
+			Name: fmt.Sprintf("Line %+.1f", line),
+			Line: line,
+			Selections: []*sports.Selection{
+				{Name: fmt.Sprintf("%s %+.1f", home, line), Price: seedPrice(0.5)},
+				{Name: fmt.Sprintf("%s %+.1f", away, -line), Price: seedPrice(0.5)},
+			},
+		},
+		{
+			Type: sports.Market_TOTAL_POINTS,
+			Name: fmt.Sprintf("Total Points %.1f", total),
+			Line: total,
+			Selections: []*sports.Selection{
+				{Name: "Over", Price: seedPrice(0.5)},
+				{Name: "Under", Price: seedPrice(0.5)},
+			},
+		},
+	}
+}
+
+// Turns the chance of an outcome into a price to two decimal places, with a 5% margin for the house
+func seedPrice(chance float64) float64 {
+	return math.Max(1.01, math.Round(100/(chance*1.05))/100)
+}
+
+// Picks a sport for a sport event, weighted by the mix of sports seeded
+func (s *sportsRepo) seedSportPick() sportTypeData {
+	if len(s.seeding.Sports) == 0 {
+		return sportTypesData[s.random.Intn(len(sportTypesData))]
+	}
+
+	var total int
+	for _, sportType := range sportTypesData {
+		total += s.seeding.Sports[sportType.slug]
+	}
+	roll := s.random.Intn(total)
+	for _, sportType := range sportTypesData {
+		if roll < s.seeding.Sports[sportType.slug] {
+			return sportType
+		}
+		roll -= s.seeding.Sports[sportType.slug]
+	}
+
+	return sportTypesData[len(sportTypesData)-1]
+}
+
+// Returns the sport in the catalogue with a slug, or nil if there isn't one
+func seedSportType(slug string) *sportTypeData {
+	for i := range sportTypesData {
+		if sportTypesData[i].slug == slug {
+			return &sportTypesData[i]
+		}
+	}
+
+	return nil
+}
+
+// Lists the slugs of the sports in the catalogue, e.g. "afl, basketball, hockey"
+func seedSportSlugs() string {
+	slugs := make([]string, len(sportTypesData))
+	for i, sportType := range sportTypesData {
+		slugs[i] = sportType.slug
+	}
+
+	return strings.Join(slugs, ", ")
+}
+
+// Picks a time between from and to after the time seeded around, to the second
+func (s *sportsRepo) seedTime(from, to time.Duration) time.Time {
+	return s.seeding.Now.Add(from + time.Duration(s.random.Int63n(int64(to-from)))).Truncate(time.Second)
+}
+
+// Deletes every row seeded. IDs start over by themselves as none of the tables use AUTOINCREMENT.
+func (s *sportsRepo) resetSeed() error {
+	for _, table := range seedTables {
+		if _, err := s.db.Exec(`DELETE FROM ` + table); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns the ID of the first of the competitions for a sport
+func seedCompetitionID(competitions []*sports.Competition, sport string) int64 {
+	for _, competition := range competitions {
+		if competition.Sport == sport {
+			return competition.Id
+		}
+	}
+
+	return 0
+}
+
+// Returns every team, keyed by the competition they play in
+func (s *sportsRepo) teamsByCompetition() (map[int64][]*sports.Team, error) {
+	teams, err := s.ListTeams(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byCompetition := make(map[int64][]*sports.Team)
+	for _, team := range teams {
+		byCompetition[team.CompetitionId] = append(byCompetition[team.CompetitionId], team)
+	}
+
+	return byCompetition, nil
+}
+
+// Picks a status for a sport event. Upcoming events are mostly scheduled, those that started in the
+// last couple of hours are in play and the rest have mostly finished.
+func (s *sportsRepo) seedSportStatus(advertisedStart time.Time) sports.SportEvent_Status {
+	roll := s.random.Intn(10)
+
+	switch {
+	case advertisedStart.After(s.seeding.Now):
+		if roll == 0 {
+			return sports.SportEvent_POSTPONED
+		}
+		return sports.SportEvent_SCHEDULED
+	case advertisedStart.After(s.seeding.Now.Add(-2 * time.Hour)):
+		if roll < 3 {
+			return sports.SportEvent_BREAK
+		}
+		return sports.SportEvent_LIVE
+	case roll == 0:
+		return sports.SportEvent_CANCELLED
+	default:
+		return sports.SportEvent_FINISHED
+	}
+}
+
+// Picks how many of the periods in a game have been played given the status of the sport event
+func (s *sportsRepo) seedPeriodsPlayed(periods int, status sports.SportEvent_Status) int {
+	switch status {
+	case sports.SportEvent_LIVE, sports.SportEvent_BREAK:
+		return 1 + s.random.Intn(periods)
+	case sports.SportEvent_FINISHED:
+		return periods
+	default:
+		return 0
+	}
+}
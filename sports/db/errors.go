@@ -0,0 +1,31 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned when the resource asked for doesn't exist.
+var ErrNotFound = errors.New("not found")
+
+// ErrVersionConflict is returned when a write is made against a version of a resource that has
+// since been changed by someone else.
+var ErrVersionConflict = errors.New("version conflict")
+
+// InvalidFieldError is returned when a field in a request can't be used, e.g. an orderBy that
+// isn't one of the fields results can be ordered by.
+type InvalidFieldError struct {
+	// Field is the name of the field in the request.
+	Field string
+	// Description explains what is wrong with the value given.
+	Description string
+}
+
+func (e *InvalidFieldError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Description)
+}
+
+// Builds the error returned when a resource can't be found
+func notFound(resource string, id int64) error {
+	return fmt.Errorf("%w: %s %d", ErrNotFound, resource, id)
+}
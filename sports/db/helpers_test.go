@@ -0,0 +1,120 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// Seeds a small, fixed set of sport events, so every run of the tests sees the same data
+func testSeedOptions() SeedOptions {
+	return SeedOptions{
+		Seed:        1,
+		SportEvents: 20,
+		Now:         time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		From:        -24 * time.Hour,
+		To:          48 * time.Hour,
+	}
+}
+
+// Opens a database in a SQLite file that is removed after the test, through the driver named
+func openSQLite(t *testing.T, driver string) *sql.DB {
+	t.Helper()
+
+	sportsDB, err := sql.Open(driver, filepath.Join(t.TempDir(), "sports.db"))
+	if err != nil {
+		t.Fatalf("opening the database: %s", err)
+	}
+	t.Cleanup(func() { sportsDB.Close() })
+
+	return sportsDB
+}
+
+// Opens a seeded sports repository stored in a SQLite file that is removed after the test
+func newSQLiteSportsRepo(t *testing.T) *sportsRepo {
+	t.Helper()
+
+	return newTestSportsRepo(t, openSQLite(t, "sqlite3"))
+}
+
+// Migrates and seeds a sports repository
+func newTestSportsRepo(t *testing.T, sportsDB *sql.DB) *sportsRepo {
+	t.Helper()
+
+	if _, err := NewMigrator(sportsDB).Up(context.Background(), 0); err != nil {
+		t.Fatalf("migrating the database: %s", err)
+	}
+
+	repo := NewSportsRepo(sportsDB).(*sportsRepo)
+	if err := repo.Init(testSeedOptions()); err != nil {
+		t.Fatalf("seeding the database: %s", err)
+	}
+
+	return repo
+}
+
+// heldDriver is a SQLite driver whose strftime can hold up the query running it, which filtering
+// sport events by their advertised start time does for every sport event. It keeps a query
+// running while its context is cancelled or passes its deadline.
+const heldDriver = "sqlite3_held"
+
+func init() {
+	sql.Register(heldDriver, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("strftime", heldStrftime, false)
+		},
+	})
+}
+
+// queryHold holds up a query run through heldDriver until the test releases it
+type queryHold struct {
+	// started is closed once the query is running
+	started chan struct{}
+	release chan struct{}
+
+	start, stop sync.Once
+}
+
+var heldQuery atomic.Pointer[queryHold]
+
+// Holds up the next query run through heldDriver, letting it go by the end of the test at the latest
+func holdQuery(t *testing.T) *queryHold {
+	hold := &queryHold{started: make(chan struct{}), release: make(chan struct{})}
+	heldQuery.Store(hold)
+	t.Cleanup(func() {
+		heldQuery.Store(nil)
+		hold.Release()
+	})
+
+	return hold
+}
+
+// Release lets the query held up carry on
+func (h *queryHold) Release() {
+	h.stop.Do(func() { close(h.release) })
+}
+
+// Works out strftime('%s', value), the only way sport events use it, once any query hold is released
+func heldStrftime(format, value string) (int64, error) {
+	if hold := heldQuery.Load(); hold != nil {
+		hold.start.Do(func() { close(hold.started) })
+		<-hold.release
+	}
+
+	if format != "%s" {
+		return 0, fmt.Errorf("strftime format %q isn't supported", format)
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, err
+	}
+
+	return at.Unix(), nil
+}
@@ -0,0 +1,370 @@
+package db
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+)
+
+// A column of an imported file, and whether every row has to give it
+type importColumn struct {
+	name     string
+	required bool
+}
+
+// The columns of an imported file of sport events. Competitions are named rather than given by
+// ID, and the teams of a sport event in a competition are matched up by name.
+var importColumns = []importColumn{
+	{"external_id", true},
+	{"name", false},
+	{"sport", true},
+	{"home_competitor", true},
+	{"away_competitor", true},
+	{"advertised_start_time", true},
+	{"status", false},
+	{"home_points", false},
+	{"away_points", false},
+	{"competition", false},
+}
+
+// A row of an imported file by column, along with any problems reading it
+type importRow struct {
+	line   int
+	values map[string]string
+	errors []*sports.ImportError
+}
+
+// Adds a problem with a column of the row
+func (r *importRow) fail(field, description string) {
+	r.errors = append(r.errors, &sports.ImportError{
+		Line:        int32(r.line),
+		ExternalId:  r.values["external_id"],
+		Field:       field,
+		Description: description,
+	})
+}
+
+// Creates or updates sport events by their external ID from the rows of a CSV or NDJSON file. Rows
+// with problems are left out and reported, and the rest are imported together, or only checked on
+// a dry run.
+func (s *sportsRepo) BulkImport(ctx context.Context, format sports.ImportFormat, data string, dryRun bool) (*sports.BulkImportResponse, error) {
+	rows, err := readImportRows(format, data, importColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the catalogue up front, the transaction is the only connection to the database after this
+	sportTypes, err := s.ListSportTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	response := &sports.BulkImportResponse{DryRun: dryRun}
+	for _, row := range rows {
+		var created bool
+		if len(row.errors) == 0 {
+			if created, err = importSportEvent(ctx, tx, sportTypes, row); err != nil {
+				return nil, err
+			}
+		}
+
+		switch {
+		case len(row.errors) > 0:
+			response.Failed++
+			response.Errors = append(response.Errors, row.errors...)
+		case created:
+			response.Created++
+		default:
+			response.Updated++
+		}
+	}
+
+	// A dry run goes through every write so teams added by earlier rows are found, then undoes them
+	if dryRun {
+		return response, nil
+	}
+
+	return response, tx.Commit()
+}
+
+// Creates or updates a sport event from a row, reporting whether it was created. The row replaces
+// everything about the sport event, so anything it leaves out goes back to its default.
+func importSportEvent(ctx context.Context, tx *sql.Tx, sportTypes []*sports.SportType, row *importRow) (bool, error) {
+	sport, err := matchSportSlug(sportTypes, "sport", row.values["sport"])
+	var invalid *InvalidFieldError
+	if errors.As(err, &invalid) {
+		row.fail("sport", invalid.Description)
+	}
+	home, away := row.values["home_competitor"], row.values["away_competitor"]
+	if home != "" && strings.EqualFold(home, away) {
+		row.fail("away_competitor", "must be a different competitor to home_competitor")
+	}
+	advertisedStart, err := time.Parse(time.RFC3339, row.values["advertised_start_time"])
+	if err != nil {
+		row.fail("advertised_start_time", fmt.Sprintf("%q is not an RFC 3339 time, e.g. 2024-01-01T12:00:00Z", row.values["advertised_start_time"]))
+	}
+	status := sports.SportEvent_SCHEDULED.String()
+	if value := strings.ToUpper(row.values["status"]); value != "" {
+		if sports.SportEvent_Status_value[value] == 0 {
+			row.fail("status", fmt.Sprintf("%q is not a sport event status, e.g. SCHEDULED or FINISHED", row.values["status"]))
+		}
+		status = value
+	}
+	var points [2]int64
+	for i, column := range []string{"home_points", "away_points"} {
+		if value := row.values[column]; value != "" {
+			if points[i], err = strconv.ParseInt(value, 10, 64); err != nil || points[i] < 0 {
+				row.fail(column, fmt.Sprintf("%q is not a number from 0", value))
+			}
+		}
+	}
+	name := row.values["name"]
+	if name == "" {
+		name = fmt.Sprintf("%s VS %s", home, away)
+	}
+
+	var competitionID, homeTeamID, awayTeamID interface{}
+	if competition := row.values["competition"]; competition != "" && sport != "" {
+		var id int64
+		err := tx.QueryRowContext(ctx, `SELECT MIN(id) FROM competitions WHERE sport = ? AND name = ? COLLATE NOCASE HAVING COUNT(*) > 0`, sport, competition).Scan(&id)
+		if err == sql.ErrNoRows {
+			row.fail("competition", fmt.Sprintf("no %s competition is named %q", sport, competition))
+		} else if err != nil {
+			return false, err
+		}
+		competitionID = id
+	}
+	if len(row.errors) > 0 {
+		return false, nil
+	}
+
+	// Competitors who haven't played in the competition before join it as new teams
+	if competitionID != nil {
+		if homeTeamID, err = importedTeam(ctx, tx, competitionID.(int64), home); err != nil {
+			return false, err
+		}
+		if awayTeamID, err = importedTeam(ctx, tx, competitionID.(int64), away); err != nil {
+			return false, err
+		}
+	}
+
+	args := []interface{}{
+		name,
+		advertisedStart.Format(time.RFC3339),
+		sport,
+		fmt.Sprintf("%d-%d", points[0], points[1]),
+		home,
+		away,
+		points[0],
+		points[1],
+		status,
+		competitionID,
+		homeTeamID,
+		awayTeamID,
+	}
+	var id int64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM sports WHERE external_id = ?`, row.values["external_id"]).Scan(&id)
+	if err == sql.ErrNoRows {
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO sports(name, advertised_start_time, sport, current_score, home_competitor, away_competitor, home_points, away_points, status, competition_id, home_team_id, away_team_id, external_id, version) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,1)`,
+			append(args, row.values["external_id"])...,
+		)
+		return true, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE sports SET name = ?, advertised_start_time = ?, sport = ?, current_score = ?, home_competitor = ?, away_competitor = ?, home_points = ?, away_points = ?, status = ?, competition_id = ?, home_team_id = ?, away_team_id = ?, version = version + 1 WHERE id = ?`,
+		append(args, id)...,
+	)
+	return false, err
+}
+
+// Finds the team in a competition with a name, adding it if there isn't one
+func importedTeam(ctx context.Context, tx *sql.Tx, competitionID int64, name string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, `SELECT MIN(id) FROM teams WHERE competition_id = ? AND name = ? COLLATE NOCASE HAVING COUNT(*) > 0`, competitionID, name).Scan(&id)
+	if err != sql.ErrNoRows {
+		return id, err
+	}
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO teams(competition_id, name) VALUES (?,?)`, competitionID, name)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.LastInsertId()
+}
+
+// Reads the rows of a file, checking each has the columns given and nothing else. Problems with
+// the file as a whole are returned as an error, while problems with a row are left on the row.
+func readImportRows(format sports.ImportFormat, data string, columns []importColumn) ([]*importRow, error) {
+	known := make(map[string]bool)
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		known[column.name] = true
+		names[i] = column.name
+	}
+
+	var (
+		rows []*importRow
+		err  error
+	)
+	switch format {
+	case sports.ImportFormat_CSV:
+		rows, err = readImportCSV(data, known, names)
+	case sports.ImportFormat_NDJSON:
+		rows, err = readImportNDJSON(data, known, names)
+	default:
+		return nil, &InvalidFieldError{Field: "format", Description: "must be CSV or NDJSON"}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, &InvalidFieldError{Field: "data", Description: "has no rows to import"}
+	}
+
+	for _, row := range rows {
+		// Rows that couldn't be read at all only report that
+		if len(row.errors) > 0 && row.errors[0].Field == "" {
+			continue
+		}
+		for _, column := range columns {
+			if column.required && row.values[column.name] == "" {
+				row.fail(column.name, "is required")
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+// Reads a CSV file with a header naming the columns
+func readImportCSV(data string, known map[string]bool, names []string) ([]*importRow, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	// Rows with the wrong number of values are reported rather than stopping the import
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, &InvalidFieldError{Field: "data", Description: "is empty, it needs a header naming the columns"}
+	}
+	if err != nil {
+		return nil, &InvalidFieldError{Field: "data", Description: err.Error()}
+	}
+	seen := make(map[string]bool)
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !known[name] {
+			return nil, &InvalidFieldError{Field: "data", Description: fmt.Sprintf("line 1: %q is not a column, use %s", name, strings.Join(names, ", "))}
+		}
+		if seen[name] {
+			return nil, &InvalidFieldError{Field: "data", Description: fmt.Sprintf("line 1: %q is in the header twice", name)}
+		}
+		seen[name] = true
+		header[i] = name
+	}
+
+	var rows []*importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &InvalidFieldError{Field: "data", Description: err.Error()}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &importRow{line: line, values: make(map[string]string)}
+		for i, value := range record {
+			if i < len(header) {
+				row.values[header[i]] = strings.TrimSpace(value)
+			}
+		}
+		if len(record) != len(header) {
+			row.fail("", fmt.Sprintf("has %d values, the header names %d columns", len(record), len(header)))
+		}
+		rows = append(rows, row)
+	}
+}
+
+// Reads an NDJSON file, a JSON object on each line keyed by column. Blank lines are skipped.
+func readImportNDJSON(data string, known map[string]bool, names []string) ([]*importRow, error) {
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), len(data)+1)
+
+	var rows []*importRow
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		row := &importRow{line: line, values: make(map[string]string)}
+		rows = append(rows, row)
+
+		var object map[string]interface{}
+		decoder := json.NewDecoder(strings.NewReader(text))
+		decoder.UseNumber()
+		if err := decoder.Decode(&object); err != nil || object == nil {
+			row.fail("", "is not a JSON object")
+			continue
+		}
+
+		// Read the external ID first so every problem with the row can name it
+		if id, ok := object["external_id"].(string); ok {
+			row.values["external_id"] = strings.TrimSpace(id)
+		}
+		keys := make([]string, 0, len(object))
+		for name := range object {
+			keys = append(keys, name)
+		}
+		sort.Strings(keys)
+		for _, name := range keys {
+			if !known[name] {
+				row.fail(name, fmt.Sprintf("is not a column, use %s", strings.Join(names, ", ")))
+				continue
+			}
+			switch value := object[name].(type) {
+			case nil:
+			case string:
+				row.values[name] = strings.TrimSpace(value)
+			case json.Number:
+				row.values[name] = value.String()
+			case bool:
+				row.values[name] = strconv.FormatBool(value)
+			default:
+				row.fail(name, "must be a string, number or boolean")
+			}
+		}
+	}
+
+	return rows, scanner.Err()
+}
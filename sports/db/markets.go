@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+)
+
+// Lists the markets on a sport event, primary market first, along with their selections
+func (s *sportsRepo) ListMarkets(ctx context.Context, sportEventID int64) ([]*sports.Market, error) {
+	return s.listMarkets(ctx, "sport_event_id = ?", sportEventID)
+}
+
+// Gets the primary market on a sport event along with its selections, or nil if it hasn't got one
+func (s *sportsRepo) GetPrimaryMarket(ctx context.Context, sportEventID int64) (*sports.Market, error) {
+	markets, err := s.listMarkets(ctx, "sport_event_id = ? AND is_primary = 1", sportEventID)
+	if err != nil || len(markets) == 0 {
+		return nil, err
+	}
+
+	return markets[0], nil
+}
+
+// Lists the markets matching a clause and fills in their selections
+func (s *sportsRepo) listMarkets(ctx context.Context, clause string, args ...interface{}) ([]*sports.Market, error) {
+	rows, err := s.db.QueryContext(ctx, getMarketQueries()[marketsList]+" WHERE "+clause+" ORDER BY is_primary DESC, id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		markets   []*sports.Market
+		marketIDs []interface{}
+	)
+	byID := make(map[int64]*sports.Market)
+	for rows.Next() {
+		var (
+			market     sports.Market
+			marketType string
+		)
+		if err := rows.Scan(&market.Id, &market.SportEventId, &marketType, &market.Name, &market.Line, &market.Primary); err != nil {
+			return nil, err
+		}
+		market.Type = sports.Market_Type(sports.Market_Type_value[marketType])
+
+		markets = append(markets, &market)
+		marketIDs = append(marketIDs, market.Id)
+		byID[market.Id] = &market
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(markets) == 0 {
+		return nil, nil
+	}
+
+	selectionRows, err := s.db.QueryContext(
+		ctx,
+		getMarketQueries()[selectionsList]+" WHERE market_id IN ("+strings.Repeat("?,", len(marketIDs)-1)+"?) ORDER BY market_id, id",
+		marketIDs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer selectionRows.Close()
+
+	for selectionRows.Next() {
+		var selection sports.Selection
+		if err := selectionRows.Scan(&selection.Id, &selection.MarketId, &selection.Name, &selection.Price); err != nil {
+			return nil, err
+		}
+
+		market := byID[selection.MarketId]
+		market.Selections = append(market.Selections, &selection)
+	}
+
+	return markets, selectionRows.Err()
+}
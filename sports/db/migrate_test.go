@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+)
+
+func TestMigrateFillsInLegacySportEvents(t *testing.T) {
+	sportsDB := openSQLite(t, "sqlite3")
+
+	// The table and data sport events were seeded with before there were migrations
+	_, err := sportsDB.Exec(`CREATE TABLE sports (id INTEGER PRIMARY KEY, name TEXT, advertised_start_time DATETIME, sport TEXT, current_score TEXT)`)
+	if err == nil {
+		_, err = sportsDB.Exec(`INSERT INTO sports VALUES (1, 'Sydney Swans VS Geelong Cats', '2024-02-26T13:10:17+10:00', 'Rugby League', '12-8')`)
+	}
+	if err != nil {
+		t.Fatalf("creating the legacy database: %s", err)
+	}
+
+	repo := newTestSportsRepo(t, sportsDB)
+
+	sport, err := repo.GetSportEventByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetSportEventByID() returned error %v", err)
+	}
+
+	score := sport.GetScore()
+	switch {
+	case sport.Sport != "rugby-league":
+		t.Errorf("sport is %q, want the slug rugby-league", sport.Sport)
+	case score.GetHomeCompetitor() != "Sydney Swans" || score.GetAwayCompetitor() != "Geelong Cats":
+		t.Errorf("competitors are %q and %q, want Sydney Swans and Geelong Cats", score.GetHomeCompetitor(), score.GetAwayCompetitor())
+	case score.GetHomePoints() != 12 || score.GetAwayPoints() != 8:
+		t.Errorf("points are %d-%d, want 12-8", score.GetHomePoints(), score.GetAwayPoints())
+	case sport.Status != sports.SportEvent_FINISHED:
+		t.Errorf("status is %s, want FINISHED as it started in the past", sport.Status)
+	case sport.CompetitionId != seedCompetitionID(mustListCompetitions(t, repo), "rugby-league"):
+		t.Errorf("competition is %d, want the rugby league competition", sport.CompetitionId)
+	case sport.HomeTeamId == 0 || sport.AwayTeamId == 0 || sport.HomeTeamId == sport.AwayTeamId:
+		t.Errorf("teams are %d and %d, want a team for each competitor", sport.HomeTeamId, sport.AwayTeamId)
+	}
+
+	// Every migration can be undone and applied again
+	migrator := NewMigrator(sportsDB)
+	if _, err := migrator.Down(context.Background(), 0); err != nil {
+		t.Fatalf("Down(0) returned error %v", err)
+	}
+	if _, err := migrator.Up(context.Background(), 0); err != nil {
+		t.Fatalf("Up(0) after Down(0) returned error %v", err)
+	}
+}
+
+// Lists every competition, failing the test if they can't be listed
+func mustListCompetitions(t *testing.T, repo *sportsRepo) []*sports.Competition {
+	t.Helper()
+
+	competitions, err := repo.ListCompetitions(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListCompetitions() returned error %v", err)
+	}
+
+	return competitions
+}
@@ -0,0 +1 @@
+CREATE TABLE sports (id INTEGER PRIMARY KEY, name TEXT, advertised_start_time DATETIME, sport TEXT, current_score TEXT);
@@ -0,0 +1 @@
+ALTER TABLE sports ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
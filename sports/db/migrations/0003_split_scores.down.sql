@@ -0,0 +1,6 @@
+DROP TABLE score_periods;
+DROP TABLE score_updates;
+ALTER TABLE sports DROP COLUMN away_points;
+ALTER TABLE sports DROP COLUMN home_points;
+ALTER TABLE sports DROP COLUMN away_competitor;
+ALTER TABLE sports DROP COLUMN home_competitor;
@@ -0,0 +1,16 @@
+ALTER TABLE sports ADD COLUMN home_competitor TEXT;
+ALTER TABLE sports ADD COLUMN away_competitor TEXT;
+ALTER TABLE sports ADD COLUMN home_points INTEGER;
+ALTER TABLE sports ADD COLUMN away_points INTEGER;
+-- Sport events stored before now have their competitors and points filled in from the
+-- "TeamA VS TeamB" name and "x-y" score
+UPDATE sports SET
+	home_competitor = substr(name, 1, instr(name, ' VS ') - 1),
+	away_competitor = substr(name, instr(name, ' VS ') + 4)
+WHERE instr(name, ' VS ') > 0;
+UPDATE sports SET
+	home_points = CAST(substr(current_score, 1, instr(current_score, '-') - 1) AS INTEGER),
+	away_points = CAST(substr(current_score, instr(current_score, '-') + 1) AS INTEGER)
+WHERE instr(current_score, '-') > 0;
+CREATE TABLE score_updates (id INTEGER PRIMARY KEY, sport_event_id INTEGER, home_score INTEGER, away_score INTEGER, updated_at DATETIME);
+CREATE TABLE score_periods (id INTEGER PRIMARY KEY, sport_event_id INTEGER, period INTEGER, home_points INTEGER, away_points INTEGER, UNIQUE(sport_event_id, period));
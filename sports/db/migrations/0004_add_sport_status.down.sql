@@ -0,0 +1 @@
+ALTER TABLE sports DROP COLUMN status;
@@ -0,0 +1,7 @@
+ALTER TABLE sports ADD COLUMN status TEXT;
+-- Sport events stored before now get a status from the clock, those past their advertised start
+-- time are taken to be FINISHED
+UPDATE sports SET status = CASE
+	WHEN CAST(strftime('%s', advertised_start_time) AS INTEGER) < CAST(strftime('%s', 'now') AS INTEGER) THEN 'FINISHED'
+	ELSE 'SCHEDULED'
+END;
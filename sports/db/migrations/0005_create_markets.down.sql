@@ -0,0 +1,2 @@
+DROP TABLE selections;
+DROP TABLE markets;
@@ -0,0 +1,2 @@
+CREATE TABLE markets (id INTEGER PRIMARY KEY, sport_event_id INTEGER, type TEXT, name TEXT, line REAL, is_primary INTEGER);
+CREATE TABLE selections (id INTEGER PRIMARY KEY, market_id INTEGER, name TEXT, price REAL);
@@ -0,0 +1 @@
+DROP TABLE sport_types;
@@ -0,0 +1,15 @@
+-- The catalogue of sports, in the order they are displayed
+CREATE TABLE sport_types (id INTEGER PRIMARY KEY, slug TEXT UNIQUE, display_name TEXT, icon TEXT, display_order INTEGER, period_type TEXT, periods INTEGER);
+INSERT INTO sport_types(id, slug, display_name, icon, display_order, period_type, periods) VALUES
+	(1, 'afl', 'AFL', '/icons/sports/afl.svg', 10, 'QUARTER', 4),
+	(2, 'basketball', 'Basketball', '/icons/sports/basketball.svg', 20, 'QUARTER', 4),
+	(3, 'hockey', 'Hockey', '/icons/sports/hockey.svg', 30, 'PERIOD', 3),
+	(4, 'rugby-league', 'Rugby League', '/icons/sports/rugby-league.svg', 40, 'HALF', 2),
+	(5, 'soccer', 'Soccer', '/icons/sports/soccer.svg', 50, 'HALF', 2);
+-- Sport events stored by display name, in any case, move over to the slug, e.g. "rugby League"
+-- becomes "rugby-league"
+UPDATE sports SET sport = (
+	SELECT sport_types.slug FROM sport_types WHERE lower(sport_types.display_name) = lower(sports.sport) OR sport_types.slug = lower(sports.sport)
+)
+WHERE sport NOT IN (SELECT slug FROM sport_types)
+	AND EXISTS (SELECT 1 FROM sport_types WHERE lower(sport_types.display_name) = lower(sports.sport) OR sport_types.slug = lower(sports.sport));
@@ -0,0 +1,5 @@
+ALTER TABLE sports DROP COLUMN away_team_id;
+ALTER TABLE sports DROP COLUMN home_team_id;
+ALTER TABLE sports DROP COLUMN competition_id;
+DROP TABLE teams;
+DROP TABLE competitions;
@@ -0,0 +1,24 @@
+-- A competition for each sport, whose teams are seeded
+CREATE TABLE competitions (id INTEGER PRIMARY KEY, name TEXT, sport TEXT);
+INSERT INTO competitions(id, name, sport) VALUES
+	(1, 'NBA', 'basketball'),
+	(2, 'Premier League', 'soccer'),
+	(3, 'NHL', 'hockey'),
+	(4, 'NRL Premiership', 'rugby-league'),
+	(5, 'AFL Premiership', 'afl');
+CREATE TABLE teams (id INTEGER PRIMARY KEY, competition_id INTEGER, name TEXT);
+ALTER TABLE sports ADD COLUMN competition_id INTEGER;
+ALTER TABLE sports ADD COLUMN home_team_id INTEGER;
+ALTER TABLE sports ADD COLUMN away_team_id INTEGER;
+-- Sport events stored before now go into the competition for their sport, and their competitors
+-- become teams of that competition
+UPDATE sports SET competition_id = (
+	SELECT MIN(competitions.id) FROM competitions WHERE competitions.sport = sports.sport
+);
+INSERT INTO teams(competition_id, name)
+SELECT DISTINCT competition_id, home_competitor FROM sports WHERE competition_id IS NOT NULL AND home_competitor <> ''
+UNION
+SELECT DISTINCT competition_id, away_competitor FROM sports WHERE competition_id IS NOT NULL AND away_competitor <> '';
+UPDATE sports SET
+	home_team_id = (SELECT MIN(teams.id) FROM teams WHERE teams.competition_id = sports.competition_id AND teams.name = sports.home_competitor),
+	away_team_id = (SELECT MIN(teams.id) FROM teams WHERE teams.competition_id = sports.competition_id AND teams.name = sports.away_competitor);
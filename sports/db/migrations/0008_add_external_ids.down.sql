@@ -0,0 +1,2 @@
+DROP INDEX sports_external_id;
+ALTER TABLE sports DROP COLUMN external_id;
@@ -0,0 +1,3 @@
+-- Sport events imported from files are matched up by the ID they have elsewhere
+ALTER TABLE sports ADD COLUMN external_id TEXT;
+CREATE UNIQUE INDEX sports_external_id ON sports (external_id);
@@ -0,0 +1,13 @@
+-- requires: fts5
+DROP TRIGGER sports_search_insert;
+DROP TRIGGER sports_search_delete;
+DROP TRIGGER sports_search_update;
+DROP TABLE sports_search;
+DROP TRIGGER competitions_search_insert;
+DROP TRIGGER competitions_search_delete;
+DROP TRIGGER competitions_search_update;
+DROP TABLE competitions_search;
+DROP TRIGGER teams_search_insert;
+DROP TRIGGER teams_search_delete;
+DROP TRIGGER teams_search_update;
+DROP TABLE teams_search;
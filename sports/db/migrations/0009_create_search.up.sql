@@ -0,0 +1,39 @@
+-- requires: fts5
+-- Full-text indexes over the names searched, kept up to date by triggers on the tables they index.
+-- Databases searched before there were migrations already have them, and are only reindexed.
+CREATE VIRTUAL TABLE IF NOT EXISTS sports_search USING fts5(name, content='sports', content_rowid='id');
+CREATE TRIGGER IF NOT EXISTS sports_search_insert AFTER INSERT ON sports BEGIN
+	INSERT INTO sports_search(rowid, name) VALUES (new.id, new.name);
+END;
+CREATE TRIGGER IF NOT EXISTS sports_search_delete AFTER DELETE ON sports BEGIN
+	INSERT INTO sports_search(sports_search, rowid, name) VALUES ('delete', old.id, old.name);
+END;
+CREATE TRIGGER IF NOT EXISTS sports_search_update AFTER UPDATE OF name ON sports BEGIN
+	INSERT INTO sports_search(sports_search, rowid, name) VALUES ('delete', old.id, old.name);
+	INSERT INTO sports_search(rowid, name) VALUES (new.id, new.name);
+END;
+INSERT INTO sports_search(sports_search) VALUES ('rebuild');
+CREATE VIRTUAL TABLE IF NOT EXISTS competitions_search USING fts5(name, content='competitions', content_rowid='id');
+CREATE TRIGGER IF NOT EXISTS competitions_search_insert AFTER INSERT ON competitions BEGIN
+	INSERT INTO competitions_search(rowid, name) VALUES (new.id, new.name);
+END;
+CREATE TRIGGER IF NOT EXISTS competitions_search_delete AFTER DELETE ON competitions BEGIN
+	INSERT INTO competitions_search(competitions_search, rowid, name) VALUES ('delete', old.id, old.name);
+END;
+CREATE TRIGGER IF NOT EXISTS competitions_search_update AFTER UPDATE OF name ON competitions BEGIN
+	INSERT INTO competitions_search(competitions_search, rowid, name) VALUES ('delete', old.id, old.name);
+	INSERT INTO competitions_search(rowid, name) VALUES (new.id, new.name);
+END;
+INSERT INTO competitions_search(competitions_search) VALUES ('rebuild');
+CREATE VIRTUAL TABLE IF NOT EXISTS teams_search USING fts5(name, content='teams', content_rowid='id');
+CREATE TRIGGER IF NOT EXISTS teams_search_insert AFTER INSERT ON teams BEGIN
+	INSERT INTO teams_search(rowid, name) VALUES (new.id, new.name);
+END;
+CREATE TRIGGER IF NOT EXISTS teams_search_delete AFTER DELETE ON teams BEGIN
+	INSERT INTO teams_search(teams_search, rowid, name) VALUES ('delete', old.id, old.name);
+END;
+CREATE TRIGGER IF NOT EXISTS teams_search_update AFTER UPDATE OF name ON teams BEGIN
+	INSERT INTO teams_search(teams_search, rowid, name) VALUES ('delete', old.id, old.name);
+	INSERT INTO teams_search(rowid, name) VALUES (new.id, new.name);
+END;
+INSERT INTO teams_search(teams_search) VALUES ('rebuild');
@@ -0,0 +1,126 @@
+package db
+
+const (
+	sportsList       = "list"
+	competitionsList = "list"
+	teamsList        = "list"
+	sportTypesList   = "list"
+	marketsList      = "list"
+	selectionsList   = "selections"
+	searchAll        = "all"
+)
+
+func getSportQueries() map[string]string {
+	return map[string]string{
+		sportsList: `
+			SELECT 
+				sports.id, 
+				sports.name, 
+				sports.advertised_start_time , 
+				sports.sport, 
+				sports.current_score,
+				sports.version,
+				COALESCE(home_teams.name, sports.home_competitor, ''),
+				COALESCE(away_teams.name, sports.away_competitor, ''),
+				COALESCE(sports.home_points, 0),
+				COALESCE(sports.away_points, 0),
+				COALESCE(sports.status, ''),
+				COALESCE(sports.competition_id, 0),
+				COALESCE(sports.home_team_id, 0),
+				COALESCE(sports.away_team_id, 0),
+				COALESCE(sport_types.period_type, '')
+			FROM sports
+			LEFT JOIN teams AS home_teams ON home_teams.id = sports.home_team_id
+			LEFT JOIN teams AS away_teams ON away_teams.id = sports.away_team_id
+			LEFT JOIN sport_types ON sport_types.slug = sports.sport
+		`,
+	}
+}
+
+func getCompetitionQueries() map[string]string {
+	return map[string]string{
+		competitionsList: `
+			SELECT 
+				id, 
+				name, 
+				sport 
+			FROM competitions
+		`,
+	}
+}
+
+func getTeamQueries() map[string]string {
+	return map[string]string{
+		teamsList: `
+			SELECT 
+				teams.id, 
+				teams.competition_id, 
+				teams.name 
+			FROM teams
+		`,
+	}
+}
+
+func getSportTypeQueries() map[string]string {
+	return map[string]string{
+		sportTypesList: `
+			SELECT 
+				id, 
+				slug, 
+				display_name, 
+				icon, 
+				display_order, 
+				period_type, 
+				periods 
+			FROM sport_types
+		`,
+	}
+}
+
+func getMarketQueries() map[string]string {
+	return map[string]string{
+		marketsList: `
+			SELECT 
+				id, 
+				sport_event_id, 
+				type, 
+				name, 
+				line, 
+				is_primary 
+			FROM markets
+		`,
+		selectionsList: `
+			SELECT 
+				id, 
+				market_id, 
+				name, 
+				price 
+			FROM selections
+		`,
+	}
+}
+
+func getSearchQueries() map[string]string {
+	return map[string]string{
+		// Scores are negated bm25 ranks, so higher is better. Ranks from different indexes can't be
+		// compared, so each is scaled against the best match from the same index before they are merged.
+		searchAll: `
+			SELECT type, id, name, snippet, score / MAX(score) OVER (PARTITION BY type), sport FROM (
+				SELECT 'SPORT_EVENT' AS type, sports.id AS id, sports.name AS name, snippet(sports_search, 0, '<b>', '</b>', '...', 16) AS snippet, -bm25(sports_search) AS score, COALESCE(sports.sport, '') AS sport
+				FROM sports_search JOIN sports ON sports.id = sports_search.rowid
+				WHERE sports_search MATCH ?
+				UNION ALL
+				SELECT 'COMPETITION', competitions.id, competitions.name, snippet(competitions_search, 0, '<b>', '</b>', '...', 16), -bm25(competitions_search), COALESCE(competitions.sport, '')
+				FROM competitions_search JOIN competitions ON competitions.id = competitions_search.rowid
+				WHERE competitions_search MATCH ?
+				UNION ALL
+				SELECT 'TEAM', teams.id, teams.name, snippet(teams_search, 0, '<b>', '</b>', '...', 16), -bm25(teams_search), COALESCE(competitions.sport, '')
+				FROM teams_search JOIN teams ON teams.id = teams_search.rowid
+				LEFT JOIN competitions ON competitions.id = teams.competition_id
+				WHERE teams_search MATCH ?
+			) matches
+			ORDER BY 5 DESC, 1, 2
+			LIMIT ?
+		`,
+	}
+}
@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+)
+
+// ErrSearchUnavailable is returned when searching with a SQLite build that doesn't include FTS5,
+// i.e. one built without the sqlite_fts5 tag.
+var ErrSearchUnavailable = errors.New("search needs SQLite built with FTS5, build with -tags sqlite_fts5")
+
+// Works out whether search can be used, which it can once the migration creating the full-text
+// indexes has been applied, as that needs SQLite built with FTS5
+func (s *sportsRepo) checkSearch() error {
+	var indexes int
+	if err := s.db.QueryRow(tableQuery, "sports_search").Scan(&indexes); err != nil || indexes == 0 {
+		return err
+	}
+
+	searchable, err := fts5(s.db)
+	s.searchable = searchable
+	return err
+}
+
+// Reports whether SQLite was built with FTS5
+func fts5(db *sql.DB) (bool, error) {
+	var supported bool
+	err := db.QueryRow(`SELECT sqlite_compileoption_used('ENABLE_FTS5')`).Scan(&supported)
+	return supported, err
+}
+
+// Search returns the sport events, competitions and teams whose names best match the text given, up to
+// count of them. Every word has to match and the last can be the start of a word, so results
+// come back as the text is typed.
+func (s *sportsRepo) Search(ctx context.Context, text string, count int) ([]*sports.SearchResult, error) {
+	if !s.searchable {
+		return nil, ErrSearchUnavailable
+	}
+
+	match := searchMatch(text)
+	rows, err := s.db.QueryContext(ctx, getSearchQueries()[searchAll], match, match, match, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*sports.SearchResult
+	for rows.Next() {
+		var (
+			result     sports.SearchResult
+			resultType string
+		)
+		if err := rows.Scan(&resultType, &result.Id, &result.Name, &result.Snippet, &result.Score, &result.Sport); err != nil {
+			return nil, err
+		}
+		result.Type = sports.SearchResult_Type(sports.SearchResult_Type_value[resultType])
+
+		results = append(results, &result)
+	}
+
+	return results, rows.Err()
+}
+
+// Turns the text typed into an FTS5 query, quoting each word so punctuation can't be read as
+// query syntax, and matching the last word as a prefix
+func searchMatch(text string) string {
+	words := strings.Fields(text)
+	for i, word := range words {
+		words[i] = `"` + strings.ReplaceAll(word, `"`, `""`) + `"`
+	}
+	if len(words) > 0 {
+		words[len(words)-1] += "*"
+	}
+
+	return strings.Join(words, " ")
+}
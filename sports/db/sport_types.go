@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+)
+
+// Lists the sport types in the catalogue in the order they are displayed
+func (s *sportsRepo) ListSportTypes(ctx context.Context) ([]*sports.SportType, error) {
+	rows, err := s.db.QueryContext(ctx, getSportTypeQueries()[sportTypesList]+" ORDER BY display_order, id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sportTypes []*sports.SportType
+	for rows.Next() {
+		var (
+			sportType  sports.SportType
+			periodType string
+		)
+		if err := rows.Scan(&sportType.Id, &sportType.Slug, &sportType.DisplayName, &sportType.Icon, &sportType.DisplayOrder, &periodType, &sportType.Periods); err != nil {
+			return nil, err
+		}
+		sportType.PeriodType = sports.Score_PeriodType(sports.Score_PeriodType_value[periodType])
+
+		sportTypes = append(sportTypes, &sportType)
+	}
+
+	return sportTypes, rows.Err()
+}
+
+// Matches a sport, given as a slug or display name in any case, to the slug of a sport type in the
+// catalogue. Anything else is reported as an invalid value for the field named.
+func (s *sportsRepo) sportSlug(ctx context.Context, field, sport string) (string, error) {
+	sportTypes, err := s.ListSportTypes(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return matchSportSlug(sportTypes, field, sport)
+}
+
+// Matches a sport to the slug of one of the sport types given, as sportSlug does
+func matchSportSlug(sportTypes []*sports.SportType, field, sport string) (string, error) {
+	sport = strings.TrimSpace(sport)
+	slugs := make([]string, len(sportTypes))
+	for i, sportType := range sportTypes {
+		if strings.EqualFold(sport, sportType.Slug) || strings.EqualFold(sport, sportType.DisplayName) {
+			return sportType.Slug, nil
+		}
+		slugs[i] = sportType.Slug
+	}
+
+	return "", &InvalidFieldError{Field: field, Description: fmt.Sprintf("%q isn't one of %s", sport, strings.Join(slugs, ", "))}
+}
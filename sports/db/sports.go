@@ -0,0 +1,706 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+)
+
+// SportsRepo provides repository access to sport events.
+type SportsRepo interface {
+	// Init will initialise our sports repository, seeding it with the options given.
+	Init(seeding SeedOptions) error
+
+	// ListSports will return a list of sport events.
+	ListSports(ctx context.Context, filter *sports.ListSportsRequestFilter) ([]*sports.SportEvent, error)
+	// ListSportsPage will return a page of sport events and the token for the next page, which is empty on the last page
+	ListSportsPage(ctx context.Context, filter *sports.ListSportsRequestFilter, pageSize int32, pageToken string) ([]*sports.SportEvent, string, error)
+	// GetSportEventByID will return a single sport event based on the ID provided, or ErrNotFound if there is none
+	GetSportEventByID(ctx context.Context, id int64) (*sports.SportEvent, error)
+	// CreateSportEvent will add a new sport event and return it as stored
+	CreateSportEvent(ctx context.Context, sport *sports.SportEvent) (*sports.SportEvent, error)
+	// UpdateSportEvent will change the named fields of a sport event, or return ErrVersionConflict if it has changed since the version given
+	UpdateSportEvent(ctx context.Context, sport *sports.SportEvent, fields []string) (*sports.SportEvent, error)
+	// TransitionStatus will move a sport event to a new status, returning ErrInvalidTransition if it can't
+	TransitionStatus(ctx context.Context, id int64, status sports.SportEvent_Status) (*sports.SportEvent, error)
+	// UpdateScore will set the current score of a sport event and record the change. Periods replace the
+	// breakdown of the score unless there are none.
+	UpdateScore(ctx context.Context, id, homeScore, awayScore int64, periods []*sports.PeriodScore) (*sports.SportEvent, *sports.ScoreUpdate, error)
+
+	// ListMarkets will return the markets on a sport event along with their selections
+	ListMarkets(ctx context.Context, sportEventID int64) ([]*sports.Market, error)
+	// GetPrimaryMarket will return the main market on a sport event, or nil if it hasn't got one
+	GetPrimaryMarket(ctx context.Context, sportEventID int64) (*sports.Market, error)
+	// ListSportTypes will return the catalogue of sports in the order they are displayed
+	ListSportTypes(ctx context.Context) ([]*sports.SportType, error)
+	// ListCompetitions will return a list of competitions
+	ListCompetitions(ctx context.Context, filter *sports.ListCompetitionsRequestFilter) ([]*sports.Competition, error)
+	// ListTeams will return a list of teams
+	ListTeams(ctx context.Context, filter *sports.ListTeamsRequestFilter) ([]*sports.Team, error)
+	// Search will return the sport events, competitions and teams best matching the text,
+	// returning ErrSearchUnavailable if SQLite was built without FTS5
+	Search(ctx context.Context, text string, count int) ([]*sports.SearchResult, error)
+	// BulkImport will create or update sport events by their external ID from a CSV or NDJSON file,
+	// reporting the rows it left out. A dry run stores nothing.
+	BulkImport(ctx context.Context, format sports.ImportFormat, data string, dryRun bool) (*sports.BulkImportResponse, error)
+}
+
+type sportsRepo struct {
+	db   *sql.DB
+	init sync.Once
+	// searchable is set once the full-text indexes are ready
+	searchable bool
+	// seeding is what Init seeds, with random numbers made up from its Seed
+	seeding SeedOptions
+	random  *rand.Rand
+}
+
+// NewSportsRepo creates a new sports repository.
+func NewSportsRepo(db *sql.DB) SportsRepo {
+	return &sportsRepo{db: db}
+}
+
+// Init prepares the sports repository dummy data.
+func (s *sportsRepo) Init(seeding SeedOptions) error {
+	if err := seeding.validate(); err != nil {
+		return err
+	}
+
+	var err error
+
+	s.init.Do(func() {
+		s.seeding = seeding
+
+		err = s.checkSearch()
+
+		// For test/example purposes, we seed the DB with some dummy sport events.
+		if err == nil {
+			err = s.seed()
+		}
+	})
+
+	return err
+}
+
+// Compiles the List of sports and applies filters if present
+func (s *sportsRepo) ListSports(ctx context.Context, filter *sports.ListSportsRequestFilter) ([]*sports.SportEvent, error) {
+	sportEvents, _, err := s.ListSportsPage(ctx, filter, 0, "")
+	return sportEvents, err
+}
+
+// Compiles a page of sports after the page token, if any, and applies filters if present.
+// A pageSize of zero returns every sport event.
+func (s *sportsRepo) ListSportsPage(ctx context.Context, filter *sports.ListSportsRequestFilter, pageSize int32, pageToken string) ([]*sports.SportEvent, string, error) {
+	var (
+		query   string
+		args    []interface{}
+		clauses []string
+		orderBy string
+		sort    string
+		fields  []orderField
+	)
+	// Create a bucket of valid fields that we can order by
+	validFields := []string{"name", "id", "sport", "current_score", "advertised_start_time"}
+
+	if filter != nil {
+		orderBy, sort = filter.OrderBy, filter.Sort
+		for _, key := range filter.SortKeys {
+			fields = append(fields, orderField{field: key.Field, sort: key.Sort})
+		}
+	}
+	keys, err := orderKeys("sports", validFields, orderBy, sort, fields)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Carry on from the last sport event on the previous page
+	if pageToken != "" {
+		token, err := decodePageToken(keys, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+
+		clause, afterArgs := afterClause(keys, token.Values)
+		clauses = append(clauses, clause)
+		args = append(args, afterArgs...)
+	}
+
+	query = getSportQueries()[sportsList]
+	query, args, err = s.applySportsFilter(ctx, query, filter, clauses, args)
+	if err != nil {
+		return nil, "", err
+	}
+	query += orderClause(keys)
+
+	// Fetch one extra sport event to find out if there's another page
+	if pageSize > 0 {
+		query += " LIMIT ?"
+		args = append(args, pageSize+1)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	sportEvents, err := s.scanSportEvents(rows)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.attachPeriods(ctx, sportEvents...); err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if pageSize > 0 && len(sportEvents) > int(pageSize) {
+		sportEvents = sportEvents[:pageSize]
+
+		// The token holds the stored values so it has to be made before the score is reset below
+		last := sportEvents[len(sportEvents)-1]
+		values := make([]interface{}, len(keys))
+		for i, key := range keys {
+			values[i] = sportSortValue(last, key.field)
+		}
+
+		nextPageToken, err = encodePageToken(keys, values)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	for _, sport := range sportEvents {
+		hideScoreBeforeStart(sport)
+	}
+	return sportEvents, nextPageToken, nil
+}
+
+// Returns the value of a sport event for the field it is ordered by
+func sportSortValue(sport *sports.SportEvent, field string) interface{} {
+	switch field {
+	case "name":
+		return sport.Name
+	case "sport":
+		return sport.Sport
+	case "current_score":
+		return sport.CurrentScore
+	case "advertised_start_time":
+		return sport.AdvertisedStartTime.GetSeconds()
+	default:
+		return sport.Id
+	}
+}
+
+// Get a sport by its Id
+func (s *sportsRepo) GetSportEventByID(ctx context.Context, id int64) (*sports.SportEvent, error) {
+	rows, err := s.db.QueryContext(ctx, getSportQueries()[sportsList]+" WHERE sports.id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sportEvents, err := s.scanSportEvents(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(sportEvents) == 0 {
+		return nil, notFound("sport event", id)
+	}
+
+	sport := sportEvents[0]
+	if err := s.attachPeriods(ctx, sport); err != nil {
+		return nil, err
+	}
+	hideScoreBeforeStart(sport)
+
+	return sport, nil
+}
+
+// Fills in the points scored in each period of the sport events given
+func (s *sportsRepo) attachPeriods(ctx context.Context, sportEvents ...*sports.SportEvent) error {
+	if len(sportEvents) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*sports.SportEvent, len(sportEvents))
+	args := make([]interface{}, 0, len(sportEvents))
+	for _, sport := range sportEvents {
+		byID[sport.Id] = sport
+		args = append(args, sport.Id)
+	}
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		"SELECT sport_event_id, period, home_points, away_points FROM score_periods WHERE sport_event_id IN ("+strings.Repeat("?,", len(args)-1)+"?) ORDER BY sport_event_id, period",
+		args...,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			sportEventID int64
+			period       sports.PeriodScore
+		)
+		if err := rows.Scan(&sportEventID, &period.Period, &period.HomePoints, &period.AwayPoints); err != nil {
+			return err
+		}
+
+		score := byID[sportEventID].Score
+		score.Periods = append(score.Periods, &period)
+	}
+
+	return rows.Err()
+}
+
+// A sport event that hasn't started yet can't have scored anything, so its score is shown as 0-0
+// whatever is stored
+func hideScoreBeforeStart(sport *sports.SportEvent) {
+	if hasStarted(sport.Status) {
+		return
+	}
+
+	sport.CurrentScore = "0-0"
+	sport.Score.HomePoints = 0
+	sport.Score.AwayPoints = 0
+	sport.Score.Periods = nil
+}
+
+// Moves a sport event to a new status if the lifecycle allows it. Kicking off clears any score stored
+// while it was yet to start.
+func (s *sportsRepo) TransitionStatus(ctx context.Context, id int64, status sports.SportEvent_Status) (*sports.SportEvent, error) {
+	sport, err := s.GetSportEventByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canTransition(sport.Status, status) {
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidTransition, sport.Status, status)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Only update if nobody else has moved the sport event on in the meantime
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE sports SET status = ?, version = version + 1 WHERE id = ? AND status = ?`,
+		status.String(), id, sport.Status.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if updated, err := result.RowsAffected(); err != nil {
+		return nil, err
+	} else if updated == 0 {
+		return nil, fmt.Errorf("%w: sport event %d was updated concurrently", ErrInvalidTransition, id)
+	}
+
+	if !hasStarted(sport.Status) && hasStarted(status) {
+		if _, err := tx.ExecContext(ctx, `UPDATE sports SET current_score = '0-0', home_points = 0, away_points = 0 WHERE id = ?`, id); err != nil {
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM score_periods WHERE sport_event_id = ?", id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.GetSportEventByID(ctx, id)
+}
+
+// Adds a sport event, the score starts at 0-0
+func (s *sportsRepo) CreateSportEvent(ctx context.Context, sport *sports.SportEvent) (*sports.SportEvent, error) {
+	if err := s.checkParticipants(ctx, sport); err != nil {
+		return nil, err
+	}
+	name, err := s.sportSlug(ctx, "sport.sport", sport.Sport)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO sports(name, advertised_start_time, sport, current_score, home_competitor, away_competitor, home_points, away_points, status, competition_id, home_team_id, away_team_id, version) VALUES (?,?,?,?,?,?,0,0,?,?,?,?,1)`,
+		sport.Name,
+		sport.AdvertisedStartTime.AsTime().Format(time.RFC3339),
+		name,
+		"0-0",
+		sport.GetScore().GetHomeCompetitor(),
+		sport.GetScore().GetAwayCompetitor(),
+		sports.SportEvent_SCHEDULED.String(),
+		nullID(sport.CompetitionId),
+		nullID(sport.HomeTeamId),
+		nullID(sport.AwayTeamId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetSportEventByID(ctx, id)
+}
+
+// Updates the named fields of a sport event as long as it is still at the version given
+func (s *sportsRepo) UpdateSportEvent(ctx context.Context, sport *sports.SportEvent, fields []string) (*sports.SportEvent, error) {
+	var (
+		sets []string
+		args []interface{}
+	)
+
+	if err := s.checkUpdatedParticipants(ctx, sport, fields); err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "name":
+			sets = append(sets, "name = ?")
+			args = append(args, sport.Name)
+		case "advertised_start_time":
+			sets = append(sets, "advertised_start_time = ?")
+			args = append(args, sport.AdvertisedStartTime.AsTime().Format(time.RFC3339))
+		case "sport":
+			name, err := s.sportSlug(ctx, "sport.sport", sport.Sport)
+			if err != nil {
+				return nil, err
+			}
+			sets = append(sets, "sport = ?")
+			args = append(args, name)
+		case "score.home_competitor":
+			sets = append(sets, "home_competitor = ?")
+			args = append(args, sport.GetScore().GetHomeCompetitor())
+		case "score.away_competitor":
+			sets = append(sets, "away_competitor = ?")
+			args = append(args, sport.GetScore().GetAwayCompetitor())
+		case "competition_id":
+			sets = append(sets, "competition_id = ?")
+			args = append(args, nullID(sport.CompetitionId))
+		case "home_team_id":
+			sets = append(sets, "home_team_id = ?")
+			args = append(args, nullID(sport.HomeTeamId))
+		case "away_team_id":
+			sets = append(sets, "away_team_id = ?")
+			args = append(args, nullID(sport.AwayTeamId))
+		default:
+			return nil, &InvalidFieldError{Field: "update_mask", Description: fmt.Sprintf("%q can't be updated", field)}
+		}
+	}
+	sets = append(sets, "version = version + 1")
+
+	query := "UPDATE sports SET " + strings.Join(sets, ", ") + " WHERE id = ?"
+	args = append(args, sport.Id)
+	if sport.Version != 0 {
+		query += " AND version = ?"
+		args = append(args, sport.Version)
+	}
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkWritten(ctx, result, sport.Id); err != nil {
+		return nil, err
+	}
+
+	return s.GetSportEventByID(ctx, sport.Id)
+}
+
+// Checks the participants a sport event will have once the fields being updated are changed
+func (s *sportsRepo) checkUpdatedParticipants(ctx context.Context, sport *sports.SportEvent, fields []string) error {
+	var changed bool
+	for _, field := range fields {
+		switch field {
+		case "sport", "competition_id", "home_team_id", "away_team_id":
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	updated, err := s.GetSportEventByID(ctx, sport.Id)
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		switch field {
+		case "sport":
+			updated.Sport = sport.Sport
+		case "competition_id":
+			updated.CompetitionId = sport.CompetitionId
+		case "home_team_id":
+			updated.HomeTeamId = sport.HomeTeamId
+		case "away_team_id":
+			updated.AwayTeamId = sport.AwayTeamId
+		}
+	}
+
+	return s.checkParticipants(ctx, updated)
+}
+
+// Stores an ID of zero, meaning none was given, as NULL
+func nullID(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+
+	return id
+}
+
+// Sets the current score of a sport event and keeps a record of when it changed
+func (s *sportsRepo) UpdateScore(ctx context.Context, id, homeScore, awayScore int64, periods []*sports.PeriodScore) (*sports.SportEvent, *sports.ScoreUpdate, error) {
+	update := &sports.ScoreUpdate{
+		HomeScore: homeScore,
+		AwayScore: awayScore,
+		UpdatedAt: timestamppb.New(time.Now().UTC().Truncate(time.Second)),
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(
+		ctx,
+		"UPDATE sports SET current_score = ?, home_points = ?, away_points = ?, version = version + 1 WHERE id = ?",
+		fmt.Sprintf("%d-%d", homeScore, awayScore),
+		homeScore,
+		awayScore,
+		id,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if written, err := result.RowsAffected(); err != nil {
+		return nil, nil, err
+	} else if written == 0 {
+		return nil, nil, notFound("sport event", id)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO score_updates(sport_event_id, home_score, away_score, updated_at) VALUES (?,?,?,?)`,
+		id,
+		homeScore,
+		awayScore,
+		update.UpdatedAt.AsTime().Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(periods) > 0 {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM score_periods WHERE sport_event_id = ?", id); err != nil {
+			return nil, nil, err
+		}
+		for _, period := range periods {
+			_, err = tx.ExecContext(
+				ctx,
+				`INSERT INTO score_periods(sport_event_id, period, home_points, away_points) VALUES (?,?,?,?)`,
+				id,
+				period.Period,
+				period.HomePoints,
+				period.AwayPoints,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	sport, err := s.GetSportEventByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sport, update, nil
+}
+
+// Works out why a write to a sport event didn't touch any rows, either it doesn't exist or it
+// has moved on to a newer version
+func (s *sportsRepo) checkWritten(ctx context.Context, result sql.Result, id int64) error {
+	written, err := result.RowsAffected()
+	if err != nil || written > 0 {
+		return err
+	}
+
+	var version int64
+	err = s.db.QueryRowContext(ctx, "SELECT version FROM sports WHERE id = ?", id).Scan(&version)
+	if err == sql.ErrNoRows {
+		return notFound("sport event", id)
+	}
+	if err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: sport event %d is at version %d", ErrVersionConflict, id, version)
+}
+
+// Applies filters for sports on top of any clauses already built and returns a SQL query
+func (s *sportsRepo) applySportsFilter(ctx context.Context, query string, filter *sports.ListSportsRequestFilter, clauses []string, args []interface{}) (string, []interface{}, error) {
+	if filter == nil {
+		filter = &sports.ListSportsRequestFilter{}
+	}
+	// Filters via ID's - int array
+	if len(filter.Ids) > 0 {
+		clauses = append(clauses, "sports.id IN ("+strings.Repeat("?,", len(filter.Ids)-1)+"?)")
+
+		for _, ID := range filter.Ids {
+			args = append(args, ID)
+		}
+	}
+
+	// Filter via sport
+	if filter.Sport != "" {
+		sport, err := s.sportSlug(ctx, "filter.sport", filter.Sport)
+		if err != nil {
+			return "", nil, err
+		}
+		clauses = append(clauses, "sports.sport = ?")
+		args = append(args, sport)
+	}
+
+	// Filters via competition ID - int array
+	if len(filter.CompetitionIds) > 0 {
+		clauses = append(clauses, "sports.competition_id IN ("+strings.Repeat("?,", len(filter.CompetitionIds)-1)+"?)")
+
+		for _, id := range filter.CompetitionIds {
+			args = append(args, id)
+		}
+	}
+
+	// Filters via team ID, playing either home or away - int array
+	if len(filter.TeamIds) > 0 {
+		placeholders := strings.Repeat("?,", len(filter.TeamIds)-1) + "?"
+		clauses = append(clauses, "(sports.home_team_id IN ("+placeholders+") OR sports.away_team_id IN ("+placeholders+"))")
+
+		for _, id := range filter.TeamIds {
+			args = append(args, id)
+		}
+		for _, id := range filter.TeamIds {
+			args = append(args, id)
+		}
+	}
+
+	// Filters via the advertised start time window
+	startClauses, startArgs := startTimeClauses("sports.advertised_start_time", filter.AdvertisedStartFrom, filter.AdvertisedStartTo)
+	clauses = append(clauses, startClauses...)
+	args = append(args, startArgs...)
+
+	// Filters via whether the sport event has started yet
+	switch filter.Status {
+	case sports.ListSportsRequestFilter_OPEN:
+		clauses = append(clauses, "sports.status IN (?,?)")
+		args = append(args, sports.SportEvent_SCHEDULED.String(), sports.SportEvent_POSTPONED.String())
+	case sports.ListSportsRequestFilter_CLOSED:
+		clauses = append(clauses, "sports.status NOT IN (?,?)")
+		args = append(args, sports.SportEvent_SCHEDULED.String(), sports.SportEvent_POSTPONED.String())
+	}
+
+	// Filters via status
+	if len(filter.Statuses) > 0 {
+		clauses = append(clauses, "sports.status IN ("+strings.Repeat("?,", len(filter.Statuses)-1)+"?)")
+
+		for _, status := range filter.Statuses {
+			args = append(args, status.String())
+		}
+	}
+
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	return query, args, nil
+}
+
+// Scans the SQL database and returns sport events
+func (s *sportsRepo) scanSportEvents(
+	rows *sql.Rows,
+) ([]*sports.SportEvent, error) {
+	var sportEvents []*sports.SportEvent
+
+	for rows.Next() {
+		var sport sports.SportEvent
+		var advertisedStart time.Time
+		var score sports.Score
+		var status, periodType string
+
+		if err := rows.Scan(&sport.Id, &sport.Name, &advertisedStart, &sport.Sport, &sport.CurrentScore, &sport.Version,
+			&score.HomeCompetitor, &score.AwayCompetitor, &score.HomePoints, &score.AwayPoints, &status,
+			&sport.CompetitionId, &sport.HomeTeamId, &sport.AwayTeamId, &periodType); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		ts, err := ptypes.TimestampProto(advertisedStart)
+		if err != nil {
+			return nil, err
+		}
+
+		sport.AdvertisedStartTime = ts
+
+		sport.Status = sportStatus(status)
+		score.PeriodType = sports.Score_PeriodType(sports.Score_PeriodType_value[periodType])
+		sport.Score = &score
+
+		sportEvents = append(sportEvents, &sport)
+	}
+
+	return sportEvents, rows.Err()
+}
+
+// Builds the WHERE clauses for an advertised start time window, from inclusive and to exclusive
+func startTimeClauses(column string, from, to *timestamppb.Timestamp) ([]string, []interface{}) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	if from != nil {
+		clauses = append(clauses, epochSeconds(column)+" >= ?")
+		args = append(args, from.GetSeconds())
+	}
+	if to != nil {
+		clauses = append(clauses, epochSeconds(column)+" < ?")
+		args = append(args, to.GetSeconds())
+	}
+
+	return clauses, args
+}
+
+// Converts a stored time to seconds since the epoch. Times are stored with differing UTC offsets,
+// so they have to be compared this way rather than as text.
+func epochSeconds(column string) string {
+	return "CAST(strftime('%s', " + column + ") AS INTEGER)"
+}
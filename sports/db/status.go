@@ -0,0 +1,44 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+)
+
+// ErrInvalidTransition is returned when a sport event can't move from its current status to the one requested.
+var ErrInvalidTransition = errors.New("invalid sport event status transition")
+
+// The statuses a sport event is allowed to move to from each status
+var sportTransitions = map[sports.SportEvent_Status][]sports.SportEvent_Status{
+	sports.SportEvent_SCHEDULED: {sports.SportEvent_LIVE, sports.SportEvent_POSTPONED, sports.SportEvent_CANCELLED},
+	sports.SportEvent_LIVE:      {sports.SportEvent_BREAK, sports.SportEvent_FINISHED, sports.SportEvent_CANCELLED},
+	sports.SportEvent_BREAK:     {sports.SportEvent_LIVE, sports.SportEvent_CANCELLED},
+	sports.SportEvent_POSTPONED: {sports.SportEvent_SCHEDULED, sports.SportEvent_CANCELLED},
+	// FINISHED and CANCELLED sport events are done with
+}
+
+// Reports whether a sport event can move from one status to another
+func canTransition(from, to sports.SportEvent_Status) bool {
+	for _, status := range sportTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reports whether a sport event with the status given has got underway
+func hasStarted(status sports.SportEvent_Status) bool {
+	return status != sports.SportEvent_SCHEDULED && status != sports.SportEvent_POSTPONED
+}
+
+// Works out the status of a sport event from what is stored, anything unknown is treated as SCHEDULED
+func sportStatus(stored string) sports.SportEvent_Status {
+	if status, ok := sports.SportEvent_Status_value[stored]; ok && status != 0 {
+		return sports.SportEvent_Status(status)
+	}
+
+	return sports.SportEvent_SCHEDULED
+}
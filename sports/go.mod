@@ -0,0 +1,25 @@
+module github.com/sibeyzoran/EntainGroupTest/sports
+
+go 1.22.0
+
+require (
+	github.com/golang/protobuf v1.5.3
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.19.1
+	github.com/mattn/go-sqlite3 v1.14.22
+	golang.org/x/net v0.21.0
+	google.golang.org/genproto/googleapis/api v0.0.0-20240221002015-b0ce06bbee7c
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240213162025-012b6fc9bca9
+	google.golang.org/grpc v1.62.0
+	google.golang.org/grpc/cmd/protoc-gen-go-grpc v1.3.0
+	google.golang.org/protobuf v1.32.0
+	syreclabs.com/go/faker v1.2.3
+)
+
+require (
+	github.com/kr/text v0.2.0 // indirect
+	github.com/rogpeppe/go-internal v1.12.0 // indirect
+	golang.org/x/sys v0.17.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	google.golang.org/genproto v0.0.0-20240213162025-012b6fc9bca9 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/db"
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+	"github.com/sibeyzoran/EntainGroupTest/sports/service"
+	"google.golang.org/grpc"
+)
+
+var (
+	grpcEndpoint = flag.String("grpc-endpoint", "localhost:9001", "gRPC server endpoint")
+)
+
+// Flags for the dummy data seeded on start up, which the same flags always seed the same way
+var (
+	defaultSeeding = db.DefaultSeedOptions()
+
+	seed            = flag.Int64("seed", 0, "Seed for the random dummy data, picked from the clock when 0")
+	seedTime        = flag.String("seed-time", "", "Time the dummy sport events are seeded around in RFC 3339, e.g. 2024-01-01T12:00:00Z, now when empty")
+	seedFrom        = flag.Duration("seed-from", defaultSeeding.From, "Earliest a dummy sport event starts, relative to --seed-time")
+	seedTo          = flag.Duration("seed-to", defaultSeeding.To, "Latest a dummy sport event starts, relative to --seed-time")
+	seedSportEvents = flag.Int("seed-sport-events", defaultSeeding.SportEvents, "Number of dummy sport events to seed")
+	seedSports      = flag.String("seed-sports", "", "Mix of sports to seed as slug=weight pairs, e.g. soccer=3,afl=1, every sport evenly when empty")
+	seedReset       = flag.Bool("seed-reset", false, "Delete everything stored and seed again, rather than only seeding what's missing")
+)
+
+func main() {
+	flag.Parse()
+
+	switch flag.Arg(0) {
+	case "":
+		if err := run(); err != nil {
+			log.Fatalf("failed running grpc server: %s\n", err)
+		}
+	case "migrate":
+		if err := migrate(flag.Args()[1:]); err != nil {
+			log.Fatalf("failed migrating: %s\n", err)
+		}
+	case "import":
+		if err := importFile(flag.Args()[1:]); err != nil {
+			log.Fatalf("failed importing: %s\n", err)
+		}
+	default:
+		log.Fatalf("unknown command %q, run with no command to serve, migrate to manage the schema or import to load a file\n", flag.Arg(0))
+	}
+}
+
+func run() error {
+	conn, err := net.Listen("tcp", ":9001")
+	if err != nil {
+		return err
+	}
+
+	sportsDB, err := sql.Open("sqlite3", "./db/sports.db")
+	if err != nil {
+		return err
+	}
+
+	// Bring the schema up to date before seeding
+	if err := migrateUp(context.Background(), db.NewMigrator(sportsDB), 0); err != nil {
+		return err
+	}
+
+	seeding, err := seedOptions()
+	if err != nil {
+		return err
+	}
+
+	sportsRepo := db.NewSportsRepo(sportsDB)
+	if err := sportsRepo.Init(seeding); err != nil {
+		return err
+	}
+
+	grpcServer := grpc.NewServer()
+
+	sports.RegisterSportsServer(
+		grpcServer,
+		service.NewSportingService(
+			sportsRepo,
+		),
+	)
+
+	log.Printf("gRPC server listening on: %s\n", *grpcEndpoint)
+
+	if err := grpcServer.Serve(conn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reads the seed flags, logging the seed and time picked so the same data can be seeded again
+func seedOptions() (db.SeedOptions, error) {
+	seeding := defaultSeeding
+	if *seed != 0 {
+		seeding.Seed = *seed
+	}
+	if *seedTime != "" {
+		now, err := time.Parse(time.RFC3339, *seedTime)
+		if err != nil {
+			return seeding, fmt.Errorf("invalid seed-time: %q is not an RFC 3339 time", *seedTime)
+		}
+		seeding.Now = now
+	}
+	seeding.From = *seedFrom
+	seeding.To = *seedTo
+	seeding.SportEvents = *seedSportEvents
+	seeding.Reset = *seedReset
+
+	// Each sport in the mix is weighed against the rest, e.g. soccer=3,afl=1 seeds three soccer
+	// games for every AFL game
+	if *seedSports != "" {
+		seeding.Sports = make(map[string]int)
+		for _, pair := range strings.Split(*seedSports, ",") {
+			sport, weight, found := strings.Cut(strings.TrimSpace(pair), "=")
+			parsed, err := strconv.Atoi(weight)
+			if !found || err != nil {
+				return seeding, fmt.Errorf("invalid seed-sports: %q is not a slug=weight pair", pair)
+			}
+			seeding.Sports[sport] = parsed
+		}
+	}
+
+	log.Printf("seeding with --seed %d --seed-time %s\n", seeding.Seed, seeding.Now.UTC().Format(time.RFC3339))
+
+	return seeding, nil
+}
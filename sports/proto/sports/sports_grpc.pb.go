@@ -0,0 +1,538 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: sports/sports.proto
+
+package sports
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Sports_ListSports_FullMethodName           = "/sports.Sports/ListSports"
+	Sports_GetSportByID_FullMethodName         = "/sports.Sports/GetSportByID"
+	Sports_CreateSportEvent_FullMethodName     = "/sports.Sports/CreateSportEvent"
+	Sports_UpdateSportEvent_FullMethodName     = "/sports.Sports/UpdateSportEvent"
+	Sports_TransitionSportEvent_FullMethodName = "/sports.Sports/TransitionSportEvent"
+	Sports_UpdateScore_FullMethodName          = "/sports.Sports/UpdateScore"
+	Sports_GetSportEventMarkets_FullMethodName = "/sports.Sports/GetSportEventMarkets"
+	Sports_ListSportTypes_FullMethodName       = "/sports.Sports/ListSportTypes"
+	Sports_ListCompetitions_FullMethodName     = "/sports.Sports/ListCompetitions"
+	Sports_ListTeams_FullMethodName            = "/sports.Sports/ListTeams"
+	Sports_Search_FullMethodName               = "/sports.Sports/Search"
+	Sports_BulkImport_FullMethodName           = "/sports.Sports/BulkImport"
+)
+
+// SportsClient is the client API for Sports service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SportsClient interface {
+	// ListSports will return a collection of all sports.
+	ListSports(ctx context.Context, in *ListSportsRequest, opts ...grpc.CallOption) (*ListSportsResponse, error)
+	// GetSportByID will return a single sport event
+	GetSportByID(ctx context.Context, in *GetSportByIDRequest, opts ...grpc.CallOption) (*GetSportByIDResponse, error)
+	// CreateSportEvent will add a new sport event
+	CreateSportEvent(ctx context.Context, in *CreateSportEventRequest, opts ...grpc.CallOption) (*CreateSportEventResponse, error)
+	// UpdateSportEvent will change the fields of a sport event named in the update mask
+	UpdateSportEvent(ctx context.Context, in *UpdateSportEventRequest, opts ...grpc.CallOption) (*UpdateSportEventResponse, error)
+	// TransitionSportEvent will move a sport event to a new status
+	TransitionSportEvent(ctx context.Context, in *TransitionSportEventRequest, opts ...grpc.CallOption) (*TransitionSportEventResponse, error)
+	// UpdateScore will record the latest score of a sport event
+	UpdateScore(ctx context.Context, in *UpdateScoreRequest, opts ...grpc.CallOption) (*UpdateScoreResponse, error)
+	// GetSportEventMarkets will return the betting markets for a sport event
+	GetSportEventMarkets(ctx context.Context, in *GetSportEventMarketsRequest, opts ...grpc.CallOption) (*GetSportEventMarketsResponse, error)
+	// ListSportTypes will return the catalogue of sports
+	ListSportTypes(ctx context.Context, in *ListSportTypesRequest, opts ...grpc.CallOption) (*ListSportTypesResponse, error)
+	// ListCompetitions will return a collection of all competitions.
+	ListCompetitions(ctx context.Context, in *ListCompetitionsRequest, opts ...grpc.CallOption) (*ListCompetitionsResponse, error)
+	// ListTeams will return a collection of all teams.
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	// Search will return the sport events, competitions and teams best matching the text
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// BulkImport will create or update sport events by their external ID from a CSV or NDJSON file
+	BulkImport(ctx context.Context, in *BulkImportRequest, opts ...grpc.CallOption) (*BulkImportResponse, error)
+}
+
+type sportsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSportsClient(cc grpc.ClientConnInterface) SportsClient {
+	return &sportsClient{cc}
+}
+
+func (c *sportsClient) ListSports(ctx context.Context, in *ListSportsRequest, opts ...grpc.CallOption) (*ListSportsResponse, error) {
+	out := new(ListSportsResponse)
+	err := c.cc.Invoke(ctx, Sports_ListSports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) GetSportByID(ctx context.Context, in *GetSportByIDRequest, opts ...grpc.CallOption) (*GetSportByIDResponse, error) {
+	out := new(GetSportByIDResponse)
+	err := c.cc.Invoke(ctx, Sports_GetSportByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) CreateSportEvent(ctx context.Context, in *CreateSportEventRequest, opts ...grpc.CallOption) (*CreateSportEventResponse, error) {
+	out := new(CreateSportEventResponse)
+	err := c.cc.Invoke(ctx, Sports_CreateSportEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) UpdateSportEvent(ctx context.Context, in *UpdateSportEventRequest, opts ...grpc.CallOption) (*UpdateSportEventResponse, error) {
+	out := new(UpdateSportEventResponse)
+	err := c.cc.Invoke(ctx, Sports_UpdateSportEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) TransitionSportEvent(ctx context.Context, in *TransitionSportEventRequest, opts ...grpc.CallOption) (*TransitionSportEventResponse, error) {
+	out := new(TransitionSportEventResponse)
+	err := c.cc.Invoke(ctx, Sports_TransitionSportEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) UpdateScore(ctx context.Context, in *UpdateScoreRequest, opts ...grpc.CallOption) (*UpdateScoreResponse, error) {
+	out := new(UpdateScoreResponse)
+	err := c.cc.Invoke(ctx, Sports_UpdateScore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) GetSportEventMarkets(ctx context.Context, in *GetSportEventMarketsRequest, opts ...grpc.CallOption) (*GetSportEventMarketsResponse, error) {
+	out := new(GetSportEventMarketsResponse)
+	err := c.cc.Invoke(ctx, Sports_GetSportEventMarkets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListSportTypes(ctx context.Context, in *ListSportTypesRequest, opts ...grpc.CallOption) (*ListSportTypesResponse, error) {
+	out := new(ListSportTypesResponse)
+	err := c.cc.Invoke(ctx, Sports_ListSportTypes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListCompetitions(ctx context.Context, in *ListCompetitionsRequest, opts ...grpc.CallOption) (*ListCompetitionsResponse, error) {
+	out := new(ListCompetitionsResponse)
+	err := c.cc.Invoke(ctx, Sports_ListCompetitions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, Sports_ListTeams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, Sports_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) BulkImport(ctx context.Context, in *BulkImportRequest, opts ...grpc.CallOption) (*BulkImportResponse, error) {
+	out := new(BulkImportResponse)
+	err := c.cc.Invoke(ctx, Sports_BulkImport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SportsServer is the server API for Sports service.
+// All implementations should embed UnimplementedSportsServer
+// for forward compatibility
+type SportsServer interface {
+	// ListSports will return a collection of all sports.
+	ListSports(context.Context, *ListSportsRequest) (*ListSportsResponse, error)
+	// GetSportByID will return a single sport event
+	GetSportByID(context.Context, *GetSportByIDRequest) (*GetSportByIDResponse, error)
+	// CreateSportEvent will add a new sport event
+	CreateSportEvent(context.Context, *CreateSportEventRequest) (*CreateSportEventResponse, error)
+	// UpdateSportEvent will change the fields of a sport event named in the update mask
+	UpdateSportEvent(context.Context, *UpdateSportEventRequest) (*UpdateSportEventResponse, error)
+	// TransitionSportEvent will move a sport event to a new status
+	TransitionSportEvent(context.Context, *TransitionSportEventRequest) (*TransitionSportEventResponse, error)
+	// UpdateScore will record the latest score of a sport event
+	UpdateScore(context.Context, *UpdateScoreRequest) (*UpdateScoreResponse, error)
+	// GetSportEventMarkets will return the betting markets for a sport event
+	GetSportEventMarkets(context.Context, *GetSportEventMarketsRequest) (*GetSportEventMarketsResponse, error)
+	// ListSportTypes will return the catalogue of sports
+	ListSportTypes(context.Context, *ListSportTypesRequest) (*ListSportTypesResponse, error)
+	// ListCompetitions will return a collection of all competitions.
+	ListCompetitions(context.Context, *ListCompetitionsRequest) (*ListCompetitionsResponse, error)
+	// ListTeams will return a collection of all teams.
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	// Search will return the sport events, competitions and teams best matching the text
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// BulkImport will create or update sport events by their external ID from a CSV or NDJSON file
+	BulkImport(context.Context, *BulkImportRequest) (*BulkImportResponse, error)
+}
+
+// UnimplementedSportsServer should be embedded to have forward compatible implementations.
+type UnimplementedSportsServer struct {
+}
+
+func (UnimplementedSportsServer) ListSports(context.Context, *ListSportsRequest) (*ListSportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSports not implemented")
+}
+func (UnimplementedSportsServer) GetSportByID(context.Context, *GetSportByIDRequest) (*GetSportByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSportByID not implemented")
+}
+func (UnimplementedSportsServer) CreateSportEvent(context.Context, *CreateSportEventRequest) (*CreateSportEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSportEvent not implemented")
+}
+func (UnimplementedSportsServer) UpdateSportEvent(context.Context, *UpdateSportEventRequest) (*UpdateSportEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSportEvent not implemented")
+}
+func (UnimplementedSportsServer) TransitionSportEvent(context.Context, *TransitionSportEventRequest) (*TransitionSportEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionSportEvent not implemented")
+}
+func (UnimplementedSportsServer) UpdateScore(context.Context, *UpdateScoreRequest) (*UpdateScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScore not implemented")
+}
+func (UnimplementedSportsServer) GetSportEventMarkets(context.Context, *GetSportEventMarketsRequest) (*GetSportEventMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSportEventMarkets not implemented")
+}
+func (UnimplementedSportsServer) ListSportTypes(context.Context, *ListSportTypesRequest) (*ListSportTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSportTypes not implemented")
+}
+func (UnimplementedSportsServer) ListCompetitions(context.Context, *ListCompetitionsRequest) (*ListCompetitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompetitions not implemented")
+}
+func (UnimplementedSportsServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedSportsServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedSportsServer) BulkImport(context.Context, *BulkImportRequest) (*BulkImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkImport not implemented")
+}
+
+// UnsafeSportsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SportsServer will
+// result in compilation errors.
+type UnsafeSportsServer interface {
+	mustEmbedUnimplementedSportsServer()
+}
+
+func RegisterSportsServer(s grpc.ServiceRegistrar, srv SportsServer) {
+	s.RegisterService(&Sports_ServiceDesc, srv)
+}
+
+func _Sports_ListSports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListSports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_ListSports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListSports(ctx, req.(*ListSportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_GetSportByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSportByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetSportByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_GetSportByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetSportByID(ctx, req.(*GetSportByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_CreateSportEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSportEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).CreateSportEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_CreateSportEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).CreateSportEvent(ctx, req.(*CreateSportEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_UpdateSportEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSportEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).UpdateSportEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_UpdateSportEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).UpdateSportEvent(ctx, req.(*UpdateSportEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_TransitionSportEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionSportEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).TransitionSportEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_TransitionSportEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).TransitionSportEvent(ctx, req.(*TransitionSportEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_UpdateScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).UpdateScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_UpdateScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).UpdateScore(ctx, req.(*UpdateScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_GetSportEventMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSportEventMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetSportEventMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_GetSportEventMarkets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetSportEventMarkets(ctx, req.(*GetSportEventMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListSportTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSportTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListSportTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_ListSportTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListSportTypes(ctx, req.(*ListSportTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListCompetitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompetitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListCompetitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_ListCompetitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListCompetitions(ctx, req.(*ListCompetitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_BulkImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).BulkImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sports_BulkImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).BulkImport(ctx, req.(*BulkImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Sports_ServiceDesc is the grpc.ServiceDesc for Sports service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Sports_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sports.Sports",
+	HandlerType: (*SportsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSports",
+			Handler:    _Sports_ListSports_Handler,
+		},
+		{
+			MethodName: "GetSportByID",
+			Handler:    _Sports_GetSportByID_Handler,
+		},
+		{
+			MethodName: "CreateSportEvent",
+			Handler:    _Sports_CreateSportEvent_Handler,
+		},
+		{
+			MethodName: "UpdateSportEvent",
+			Handler:    _Sports_UpdateSportEvent_Handler,
+		},
+		{
+			MethodName: "TransitionSportEvent",
+			Handler:    _Sports_TransitionSportEvent_Handler,
+		},
+		{
+			MethodName: "UpdateScore",
+			Handler:    _Sports_UpdateScore_Handler,
+		},
+		{
+			MethodName: "GetSportEventMarkets",
+			Handler:    _Sports_GetSportEventMarkets_Handler,
+		},
+		{
+			MethodName: "ListSportTypes",
+			Handler:    _Sports_ListSportTypes_Handler,
+		},
+		{
+			MethodName: "ListCompetitions",
+			Handler:    _Sports_ListCompetitions_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _Sports_ListTeams_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Sports_Search_Handler,
+		},
+		{
+			MethodName: "BulkImport",
+			Handler:    _Sports_BulkImport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sports/sports.proto",
+}
@@ -0,0 +1,337 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/db"
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Sporting interface {
+	// ListSports will return a collection of sports.
+	ListSports(ctx context.Context, in *sports.ListSportsRequest) (*sports.ListSportsResponse, error)
+	// GetSportByID will return a single sport event
+	GetSportByID(ctx context.Context, in *sports.GetSportByIDRequest) (*sports.GetSportByIDResponse, error)
+	// CreateSportEvent will add a new sport event
+	CreateSportEvent(ctx context.Context, in *sports.CreateSportEventRequest) (*sports.CreateSportEventResponse, error)
+	// UpdateSportEvent will change the fields of a sport event named in the update mask
+	UpdateSportEvent(ctx context.Context, in *sports.UpdateSportEventRequest) (*sports.UpdateSportEventResponse, error)
+	// TransitionSportEvent will move a sport event to a new status
+	TransitionSportEvent(ctx context.Context, in *sports.TransitionSportEventRequest) (*sports.TransitionSportEventResponse, error)
+	// UpdateScore will record the latest score of a sport event
+	UpdateScore(ctx context.Context, in *sports.UpdateScoreRequest) (*sports.UpdateScoreResponse, error)
+	// GetSportEventMarkets will return the betting markets for a sport event
+	GetSportEventMarkets(ctx context.Context, in *sports.GetSportEventMarketsRequest) (*sports.GetSportEventMarketsResponse, error)
+	// ListSportTypes will return the catalogue of sports.
+	ListSportTypes(ctx context.Context, in *sports.ListSportTypesRequest) (*sports.ListSportTypesResponse, error)
+	// ListCompetitions will return a collection of competitions.
+	ListCompetitions(ctx context.Context, in *sports.ListCompetitionsRequest) (*sports.ListCompetitionsResponse, error)
+	// ListTeams will return a collection of teams.
+	ListTeams(ctx context.Context, in *sports.ListTeamsRequest) (*sports.ListTeamsResponse, error)
+	// Search will return the sport events, competitions and teams best matching a search
+	Search(ctx context.Context, in *sports.SearchRequest) (*sports.SearchResponse, error)
+	// BulkImport will create or update sport events from a CSV or NDJSON file
+	BulkImport(ctx context.Context, in *sports.BulkImportRequest) (*sports.BulkImportResponse, error)
+}
+
+const (
+	// defaultSearchCount is how many results Search returns when no count is given.
+	defaultSearchCount = 20
+	// maxSearchCount caps how many results Search will return.
+	maxSearchCount = 100
+)
+
+// Fields of a sport event that can be set when creating or updating it
+var writableSportEventFields = []string{"name", "advertised_start_time", "sport", "competition_id", "home_team_id", "away_team_id", "score.home_competitor", "score.away_competitor"}
+
+// sportingService implements the Sporting interface.
+type sportingService struct {
+	sportsRepo db.SportsRepo
+}
+
+// NewSportingService instantiates and returns a new sportingService.
+func NewSportingService(sportsRepo db.SportsRepo) Sporting {
+	return &sportingService{sportsRepo}
+}
+
+func (s *sportingService) ListSports(ctx context.Context, in *sports.ListSportsRequest) (*sports.ListSportsResponse, error) {
+	sportEvents, nextPageToken, err := s.sportsRepo.ListSportsPage(ctx, in.Filter, pageSize(in.PageSize), in.PageToken)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &sports.ListSportsResponse{Sports: sportEvents, NextPageToken: nextPageToken}, nil
+}
+
+func (s *sportingService) GetSportByID(ctx context.Context, in *sports.GetSportByIDRequest) (*sports.GetSportByIDResponse, error) {
+	sport, err := s.sportsRepo.GetSportEventByID(ctx, in.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	// Embed the head to head market if asked for
+	if in.IncludePrimaryMarket {
+		sport.PrimaryMarket, err = s.sportsRepo.GetPrimaryMarket(ctx, sport.Id)
+		if err != nil {
+			return nil, grpcError(err)
+		}
+	}
+	return &sports.GetSportByIDResponse{Sport: sport}, nil
+}
+
+// Adds a new sport event
+func (s *sportingService) CreateSportEvent(ctx context.Context, in *sports.CreateSportEventRequest) (*sports.CreateSportEventResponse, error) {
+	if in.Sport == nil {
+		return nil, invalidField("sport", "must be given")
+	}
+	if err := validateSportEvent(in.Sport, writableSportEventFields); err != nil {
+		return nil, err
+	}
+
+	sport, err := s.sportsRepo.CreateSportEvent(ctx, in.Sport)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &sports.CreateSportEventResponse{Sport: sport}, nil
+}
+
+// Changes the fields of a sport event named in the update mask
+func (s *sportingService) UpdateSportEvent(ctx context.Context, in *sports.UpdateSportEventRequest) (*sports.UpdateSportEventResponse, error) {
+	if in.Sport == nil {
+		return nil, invalidField("sport", "must be given")
+	}
+
+	// The id and version say which sport event is being updated rather than being updated
+	// themselves, they turn up in the mask the gateway builds from the request body
+	var fields []string
+	for _, path := range in.UpdateMask.GetPaths() {
+		if path == "id" || path == "version" {
+			continue
+		}
+		if !contains(writableSportEventFields, path) {
+			return nil, invalidField("update_mask", fmt.Sprintf("%q can't be updated, only %s can", path, strings.Join(writableSportEventFields, ", ")))
+		}
+		fields = append(fields, path)
+	}
+	if len(fields) == 0 {
+		return nil, invalidField("update_mask", "must name at least one field to update")
+	}
+	if err := validateSportEvent(in.Sport, fields); err != nil {
+		return nil, err
+	}
+
+	sport, err := s.sportsRepo.UpdateSportEvent(ctx, in.Sport, fields)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &sports.UpdateSportEventResponse{Sport: sport}, nil
+}
+
+// Moves a sport event on to a new status
+func (s *sportingService) TransitionSportEvent(ctx context.Context, in *sports.TransitionSportEventRequest) (*sports.TransitionSportEventResponse, error) {
+	sport, err := s.sportsRepo.TransitionStatus(ctx, in.Id, in.Status)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &sports.TransitionSportEventResponse{Sport: sport}, nil
+}
+
+// Records the latest score of a sport event, which has to be in play or finished
+func (s *sportingService) UpdateScore(ctx context.Context, in *sports.UpdateScoreRequest) (*sports.UpdateScoreResponse, error) {
+	if in.HomeScore < 0 {
+		return nil, invalidField("home_score", "must not be negative")
+	}
+	if in.AwayScore < 0 {
+		return nil, invalidField("away_score", "must not be negative")
+	}
+	seen := make(map[int32]bool, len(in.Periods))
+	for _, period := range in.Periods {
+		if period.Period <= 0 {
+			return nil, invalidField("periods.period", "must be a positive number")
+		}
+		if seen[period.Period] {
+			return nil, invalidField("periods.period", fmt.Sprintf("period %d is given more than once", period.Period))
+		}
+		seen[period.Period] = true
+		if period.HomePoints < 0 || period.AwayPoints < 0 {
+			return nil, invalidField("periods", fmt.Sprintf("points in period %d must not be negative", period.Period))
+		}
+	}
+
+	sport, err := s.sportsRepo.GetSportEventByID(ctx, in.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if !scoreable(sport.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "sport event %d is %s, scores can only be recorded while it is in play or finished", sport.Id, sport.Status)
+	}
+	if err := s.checkPeriods(ctx, sport, in); err != nil {
+		return nil, err
+	}
+
+	sport, update, err := s.sportsRepo.UpdateScore(ctx, in.Id, in.HomeScore, in.AwayScore, in.Periods)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &sports.UpdateScoreResponse{Sport: sport, Update: update}, nil
+}
+
+// Checks the periods of a score fall within the game for the sport being played and add up to
+// the score given
+func (s *sportingService) checkPeriods(ctx context.Context, sport *sports.SportEvent, in *sports.UpdateScoreRequest) error {
+	if len(in.Periods) == 0 {
+		return nil
+	}
+
+	sportTypes, err := s.sportsRepo.ListSportTypes(ctx)
+	if err != nil {
+		return grpcError(err)
+	}
+
+	var homePoints, awayPoints int64
+	for _, period := range in.Periods {
+		for _, sportType := range sportTypes {
+			if sportType.Slug == sport.Sport && period.Period > sportType.Periods {
+				return invalidField("periods.period", fmt.Sprintf("period %d is past the end of the game, %s is played over %d", period.Period, sportType.DisplayName, sportType.Periods))
+			}
+		}
+		homePoints += period.HomePoints
+		awayPoints += period.AwayPoints
+	}
+	if homePoints != in.HomeScore || awayPoints != in.AwayScore {
+		return invalidField("periods", fmt.Sprintf("the points in each period add up to %d-%d, not the score of %d-%d", homePoints, awayPoints, in.HomeScore, in.AwayScore))
+	}
+
+	return nil
+}
+
+// Lists the markets for a sport event
+func (s *sportingService) GetSportEventMarkets(ctx context.Context, in *sports.GetSportEventMarketsRequest) (*sports.GetSportEventMarketsResponse, error) {
+	// Make sure the sport event exists so a missing one isn't mistaken for one without markets
+	if _, err := s.sportsRepo.GetSportEventByID(ctx, in.Id); err != nil {
+		return nil, grpcError(err)
+	}
+
+	markets, err := s.sportsRepo.ListMarkets(ctx, in.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &sports.GetSportEventMarketsResponse{Markets: markets}, nil
+}
+
+// Lists the sports in the catalogue
+func (s *sportingService) ListSportTypes(ctx context.Context, in *sports.ListSportTypesRequest) (*sports.ListSportTypesResponse, error) {
+	sportTypes, err := s.sportsRepo.ListSportTypes(ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &sports.ListSportTypesResponse{SportTypes: sportTypes}, nil
+}
+
+// Lists the competitions, filtered if a filter is given
+func (s *sportingService) ListCompetitions(ctx context.Context, in *sports.ListCompetitionsRequest) (*sports.ListCompetitionsResponse, error) {
+	competitions, err := s.sportsRepo.ListCompetitions(ctx, in.Filter)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &sports.ListCompetitionsResponse{Competitions: competitions}, nil
+}
+
+// Lists the teams, filtered if a filter is given
+func (s *sportingService) ListTeams(ctx context.Context, in *sports.ListTeamsRequest) (*sports.ListTeamsResponse, error) {
+	teams, err := s.sportsRepo.ListTeams(ctx, in.Filter)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &sports.ListTeamsResponse{Teams: teams}, nil
+}
+
+// Searches the names of sport events, competitions and teams, best match first
+func (s *sportingService) Search(ctx context.Context, in *sports.SearchRequest) (*sports.SearchResponse, error) {
+	if strings.TrimSpace(in.Q) == "" {
+		return nil, invalidField("q", "must be given")
+	}
+	count := int(in.Count)
+	if count <= 0 {
+		count = defaultSearchCount
+	}
+	if count > maxSearchCount {
+		count = maxSearchCount
+	}
+
+	results, err := s.sportsRepo.Search(ctx, in.Q, count)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &sports.SearchResponse{Results: results}, nil
+}
+
+// Imports sport events by their external ID, reporting the rows that couldn't be imported
+func (s *sportingService) BulkImport(ctx context.Context, in *sports.BulkImportRequest) (*sports.BulkImportResponse, error) {
+	response, err := s.sportsRepo.BulkImport(ctx, in.Format, in.Data, in.DryRun)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return response, nil
+}
+
+// Reports whether a sport event with the status given can have its score recorded
+func scoreable(status sports.SportEvent_Status) bool {
+	switch status {
+	case sports.SportEvent_LIVE, sports.SportEvent_BREAK, sports.SportEvent_FINISHED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Checks the named fields of a sport event hold values it can be saved with
+func validateSportEvent(sport *sports.SportEvent, fields []string) error {
+	for _, field := range fields {
+		switch field {
+		case "name":
+			if strings.TrimSpace(sport.Name) == "" {
+				return invalidField("sport.name", "must not be empty")
+			}
+		case "advertised_start_time":
+			if sport.AdvertisedStartTime == nil {
+				return invalidField("sport.advertised_start_time", "must be given")
+			}
+			if err := sport.AdvertisedStartTime.CheckValid(); err != nil {
+				return invalidField("sport.advertised_start_time", err.Error())
+			}
+		case "sport":
+			if strings.TrimSpace(sport.Sport) == "" {
+				return invalidField("sport.sport", "must be given")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Reports whether a list of fields includes the one given
+func contains(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sibeyzoran/EntainGroupTest/sports/db"
+	"github.com/sibeyzoran/EntainGroupTest/sports/proto/sports"
+)
+
+// A sports repository whose list runs until its context is done, as a slow query would
+type slowSportsRepo struct {
+	db.SportsRepo
+}
+
+func (slowSportsRepo) ListSportsPage(ctx context.Context, filter *sports.ListSportsRequestFilter, pageSize int32, pageToken string) ([]*sports.SportEvent, string, error) {
+	<-ctx.Done()
+	return nil, "", ctx.Err()
+}
+
+func TestListSportsStopsWithTheContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		cancel  bool
+		want    codes.Code
+	}{
+		{name: "canceled", timeout: time.Minute, cancel: true, want: codes.Canceled},
+		{name: "past its deadline", timeout: 10 * time.Millisecond, want: codes.DeadlineExceeded},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+			defer cancel()
+			if test.cancel {
+				time.AfterFunc(10*time.Millisecond, cancel)
+			}
+
+			_, err := NewSportingService(slowSportsRepo{}).ListSports(ctx, &sports.ListSportsRequest{})
+			if got := status.Code(err); got != test.want {
+				t.Fatalf("ListSports() returned error %v, want code %s", err, test.want)
+			}
+		})
+	}
+}